@@ -13,6 +13,8 @@ import (
 	"github.com/golang/protobuf/proto"
 	gonats "github.com/nats-io/nats.go"
 	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/auth"
+	"github.com/void616/gm.mint.sender/internal/secure"
 	senderNats "github.com/void616/gm.mint.sender/pkg/sender/nats"
 	watcherNats "github.com/void616/gm.mint.sender/pkg/watcher/nats"
 	"github.com/void616/gm.mint/amount"
@@ -21,6 +23,7 @@ import (
 var (
 	nats           *gonats.Conn
 	natsSubjPrefix *string
+	secret         *string
 	tags           = make(map[string]bool)
 	tagsLock       sync.Mutex
 )
@@ -30,6 +33,13 @@ func main() {
 	// flags
 	natsURL := flag.String("nats", "localhost:4222", "Nats server endpoint")
 	natsSubjPrefix = flag.String("nats-prefix", "", "Prefix for Nats messages subject")
+	secret = flag.String("secret", "", "Secret of the service (tag) to sign requests with")
+	natsTLS := flag.Bool("nats-tls", false, "Connect to Nats server via TLS")
+	natsCA := flag.String("nats-ca", "", "CA certificate file to verify Nats server (enables TLS)")
+	natsCert := flag.String("nats-cert", "", "Client certificate file for Nats server (enables TLS)")
+	natsKey := flag.String("nats-key", "", "Client key file for Nats server (enables TLS)")
+	natsCreds := flag.String("nats-creds", "", "User credentials file (JWT and NKey seed) for Nats server")
+	natsNKey := flag.String("nats-nkey", "", "NKey seed file for Nats server")
 	flag.Parse()
 
 	// nats prefix: add dot
@@ -39,9 +49,22 @@ func main() {
 
 	// nats connection
 	{
+		opts, err := secure.Nats{
+			TLS:   *natsTLS,
+			CA:    *natsCA,
+			Cert:  *natsCert,
+			Key:   *natsKey,
+			Creds: *natsCreds,
+			NKey:  *natsNKey,
+		}.Options()
+		if err != nil {
+			failln("Failed to setup Nats security: %v", err)
+			os.Exit(1)
+		}
+
 		nc, err := gonats.Connect(
 			*natsURL,
-			gonats.MaxReconnects(-1),
+			append([]gonats.Option{gonats.MaxReconnects(-1)}, opts...)...,
 		)
 		if err != nil {
 			failln("Failed to connect to Nats server: %v", err)
@@ -283,11 +306,17 @@ func (c *cmdAddRemoveWallet) Parse(s string) error {
 }
 
 func (c *cmdAddRemoveWallet) Perform() (string, error) {
-	req, _ := proto.Marshal(&watcherNats.AddRemove{
+	r := &watcherNats.AddRemove{
 		Service:   c.tag,
 		Add:       c.add,
 		PublicKey: []string{c.pubkey},
-	})
+	}
+	if *secret != "" {
+		if err := auth.SignMessage([]byte(*secret), &r.Timestamp, &r.Signature, r); err != nil {
+			return "", fmt.Errorf("sign request: %v", err)
+		}
+	}
+	req, _ := proto.Marshal(r)
 	msg, err := nats.Request(*natsSubjPrefix+watcherNats.AddRemove{}.Subject(), req, time.Second*5)
 	if err != nil || msg == nil {
 		return "", fmt.Errorf("send request: %v", err)
@@ -337,11 +366,17 @@ func (c *cmdApprove) Parse(s string) error {
 
 func (c *cmdApprove) Perform() (string, error) {
 	id := fmt.Sprint(time.Now().UTC().UnixNano())
-	req, _ := proto.Marshal(&senderNats.Approve{
+	r := &senderNats.Approve{
 		Service:   c.tag,
 		Id:        id,
 		PublicKey: c.pubkey,
-	})
+	}
+	if *secret != "" {
+		if err := auth.SignMessage([]byte(*secret), &r.Timestamp, &r.Signature, r); err != nil {
+			return "", fmt.Errorf("sign request: %v", err)
+		}
+	}
+	req, _ := proto.Marshal(r)
 	msg, err := nats.Request(*natsSubjPrefix+senderNats.Approve{}.Subject(), req, time.Second*5)
 	if err != nil || msg == nil {
 		return "", fmt.Errorf("send request: %v", err)
@@ -393,13 +428,19 @@ func (c *cmdSend) Parse(s string) error {
 
 func (c *cmdSend) Perform() (string, error) {
 	id := fmt.Sprint(time.Now().UTC().UnixNano())
-	req, _ := proto.Marshal(&senderNats.Send{
+	r := &senderNats.Send{
 		Service:   c.tag,
 		Id:        id,
 		PublicKey: c.pubkey,
 		Amount:    c.amo,
 		Token:     c.token,
-	})
+	}
+	if *secret != "" {
+		if err := auth.SignMessage([]byte(*secret), &r.Timestamp, &r.Signature, r); err != nil {
+			return "", fmt.Errorf("sign request: %v", err)
+		}
+	}
+	req, _ := proto.Marshal(r)
 	msg, err := nats.Request(*natsSubjPrefix+senderNats.Send{}.Subject(), req, time.Second*5)
 	if err != nil || msg == nil {
 		return "", fmt.Errorf("send request: %v", err)
@@ -18,25 +18,33 @@ import (
 	mint "github.com/void616/gm.mint"
 	"github.com/void616/gm.mint.rpc/request"
 	"github.com/void616/gm.mint.sender/internal/alert"
+	"github.com/void616/gm.mint.sender/internal/auth"
 	"github.com/void616/gm.mint.sender/internal/metrics"
 	"github.com/void616/gm.mint.sender/internal/mint/blockobserver"
 	"github.com/void616/gm.mint.sender/internal/mint/blockparser"
 	"github.com/void616/gm.mint.sender/internal/mint/blockranger"
 	"github.com/void616/gm.mint.sender/internal/mint/rpcpool"
 	"github.com/void616/gm.mint.sender/internal/mint/txfilter"
+	"github.com/void616/gm.mint.sender/internal/retry"
+	"github.com/void616/gm.mint.sender/internal/secure"
 	serviceAPI "github.com/void616/gm.mint.sender/internal/sender/api"
 	serviceHTTP "github.com/void616/gm.mint.sender/internal/sender/api/http"
 	serviceNats "github.com/void616/gm.mint.sender/internal/sender/api/nats"
 	"github.com/void616/gm.mint.sender/internal/sender/db"
 	"github.com/void616/gm.mint.sender/internal/sender/db/mysql"
+	"github.com/void616/gm.mint.sender/internal/sender/db/postgres"
+	"github.com/void616/gm.mint.sender/internal/sender/db/sqlite"
 	"github.com/void616/gm.mint.sender/internal/sender/db/types"
+	"github.com/void616/gm.mint.sender/internal/sender/keys"
 	"github.com/void616/gm.mint.sender/internal/sender/notifier"
 	"github.com/void616/gm.mint.sender/internal/sender/txconfirmer"
 	"github.com/void616/gm.mint.sender/internal/sender/txsigner"
 	"github.com/void616/gm.mint.sender/internal/version"
-	"github.com/void616/gm.mint/signer"
+	"github.com/void616/gm.mint.sender/internal/wakeup"
+	"github.com/void616/gm.mint/amount"
 	"github.com/void616/gm.mint/transaction"
 	"github.com/void616/gotask"
+	"golang.org/x/crypto/ssh/terminal"
 	"gopkg.in/yaml.v2"
 )
 
@@ -56,8 +64,18 @@ var (
 
 func main() {
 	var argConfigFile = flag.String("config", "./config.yaml", "Config Yaml")
+	var argKeystore = flag.String("keystore", "", "Encrypt a private key into the keystore file and exit")
 	flag.Parse()
 
+	// make keystore file
+	if *argKeystore != "" {
+		if err := makeKeystore(*argKeystore); err != nil {
+			fmt.Fprintln(os.Stderr, "failed to make keystore file: "+err.Error())
+			os.Exit(1)
+		}
+		return
+	}
+
 	// config file
 	var conf config
 	{
@@ -119,33 +137,56 @@ func main() {
 	// alerter
 	var alerter alert.Alerter
 	{
+		alerters := make([]alert.Alerter, 0)
 		if conf.GCloudAlerts && alert.OnGCE() {
 			a, cls, err := alert.NewGCloud("MintSender Sender", logger.WithField("gce_alert", ""))
 			if err != nil {
 				logger.WithError(err).Fatal("Failed to setup Google Cloud alerts")
 			}
 			defer cls()
-			alerter = a
-		} else {
+			alerters = append(alerters, a)
+		}
+		if conf.Alerts.Webhook != "" {
+			alerters = append(alerters, alert.NewWebhook("MintSender Sender", conf.Alerts.Webhook, logger.WithField("webhook_alert", "")))
+		}
+		if conf.Alerts.Slack != "" {
+			alerters = append(alerters, alert.NewSlack("MintSender Sender", conf.Alerts.Slack, logger.WithField("slack_alert", "")))
+		}
+		if conf.Alerts.Telegram.Token != "" {
+			alerters = append(alerters, alert.NewTelegram("MintSender Sender", conf.Alerts.Telegram.Token, conf.Alerts.Telegram.ChatID, logger.WithField("telegram_alert", "")))
+		}
+		switch len(alerters) {
+		case 0:
 			alerter = alert.NewLogrus(logger.WithField("alert", ""))
+		case 1:
+			alerter = alerters[0]
+		default:
+			alerter = alert.NewMulti(alerters...)
 		}
 	}
-	_ = alerter
 
-	// read sender private keys, make senders
-	var senderSigners []*signer.Signer
-	{
-		for i, k := range conf.Wallets {
-			pvt, err := mint.ParsePrivateKey(k)
+	// keystore password
+	var keystorePassword string
+	if len(conf.Keystore.Files) > 0 {
+		if v, ok := os.LookupEnv(keystorePasswordEnv(&conf)); ok {
+			keystorePassword = v
+		} else {
+			v, err := readPassword("Keystore password: ")
 			if err != nil {
-				logger.WithError(err).Fatalf("Invalid sender private key at index %v", i)
+				logger.WithError(err).Fatalf("Failed to read keystore password, set it via %v env var", keystorePasswordEnv(&conf))
 			}
-			sig := signer.FromPrivateKey(pvt)
-			senderSigners = append(senderSigners, sig)
+			keystorePassword = v
 		}
-		if len(senderSigners) == 0 {
-			logger.Fatal("Add at least one sender private key in Base58")
+	}
+
+	// read sender private keys, make senders
+	var senderSigners []keys.Signer
+	{
+		list, err := loadSigners(&conf, keystorePassword)
+		if err != nil {
+			logger.WithError(err).Fatal("Failed to load senders")
 		}
+		senderSigners = list
 	}
 
 	// database
@@ -168,6 +209,28 @@ func main() {
 			db.DB.DB().SetConnMaxLifetime(time.Second * 30)
 			db.DB.LogMode(logger.Level >= logrus.TraceLevel)
 			dao = db
+		case "postgres":
+			db, err := postgres.New(conf.DB.DSN, conf.DB.Prefix)
+			if err != nil {
+				logger.WithError(err).Fatal("Failed to setup DB")
+			}
+			defer db.Close()
+			db.DB.DB().SetMaxOpenConns(8)
+			db.DB.DB().SetMaxIdleConns(1)
+			db.DB.DB().SetConnMaxLifetime(time.Second * 30)
+			db.DB.LogMode(logger.Level >= logrus.TraceLevel)
+			dao = db
+		case "sqlite":
+			db, err := sqlite.New(conf.DB.DSN, conf.DB.Prefix)
+			if err != nil {
+				logger.WithError(err).Fatal("Failed to setup DB")
+			}
+			defer db.Close()
+			db.DB.DB().SetMaxOpenConns(1)
+			db.DB.LogMode(logger.Level >= logrus.TraceLevel)
+			dao = db
+		default:
+			logger.Fatalf("Unsupported DB driver %q", conf.DB.Driver)
 		}
 
 		if !dao.Available() {
@@ -240,9 +303,16 @@ func main() {
 				logger.Fatalf("Invalid earliest block")
 			}
 			if rangerParseFrom.Cmp(latestBlockID) > 0 {
-				logger.Fatalf("Earliest block ID is greater than current latest block: %v > %v. Blockchain reset?", rangerParseFrom.String(), latestBlockID.String())
+				logger.Warnf("Earliest block ID is greater than current latest block: %v > %v. Blockchain reset, reverting transactions", rangerParseFrom.String(), latestBlockID.String())
+				if err := dao.RevertSendings(latestBlockID); err != nil {
+					logger.WithError(err).Fatal("Failed to revert sendings")
+				}
+				if err := dao.RevertApprovements(latestBlockID); err != nil {
+					logger.WithError(err).Fatal("Failed to revert approvements")
+				}
+				rangerParseFrom = nil
 			}
-			if rangerParseFrom.Cmp(latestBlockID) < 0 {
+			if rangerParseFrom != nil && rangerParseFrom.Cmp(latestBlockID) < 0 {
 				logger.Infof("Earliest block to search confirmations from is %v", rangerParseFrom.String())
 			}
 		}
@@ -256,15 +326,22 @@ func main() {
 	filteredTX := make(chan *blockparser.Transaction, 256)
 	defer close(filteredTX)
 
+	// carries fork blocks through the tx filter on reorganization
+	var checkpointsToFilter, checkpointsFiltered = make(chan *big.Int, 1), make(chan *big.Int, 1)
+
 	// carries public keys of wallets to add/remove from transactions filter
 	walletToTrack, walletToUntrack := make(chan mint.PublicKey, 32), make(chan mint.PublicKey, 32)
 	defer close(walletToTrack)
 	defer close(walletToUntrack)
 
-	// carries latest parsed block ID (just a dummy channel here)
+	// carries latest parsed block ID
 	var parsedBlockChan = make(chan *big.Int)
 	defer close(parsedBlockChan)
 
+	// carries blockchain reorganization events
+	var reorgChan = make(chan *blockparser.Reorg)
+	defer close(reorgChan)
+
 	// fresh block observer
 	var blockObserver *blockobserver.Observer
 	{
@@ -273,6 +350,8 @@ func main() {
 			rpcPool,
 			parsedTX,
 			parsedBlockChan,
+			reorgChan,
+			alerter,
 			logger.WithField("task", "block_observer"),
 		)
 		if err != nil {
@@ -288,9 +367,11 @@ func main() {
 		b, err := blockranger.New(
 			rangerParseFrom,
 			latestBlockID,
+			conf.RangerWorkers,
 			rpcPool,
 			parsedTX,
 			parsedBlockChan,
+			alerter,
 			logger.WithField("task", "block_ranger"),
 		)
 		if err != nil {
@@ -317,6 +398,7 @@ func main() {
 		if err != nil {
 			logger.WithError(err).Fatal("Failed to setup transaction filter")
 		}
+		f.AddCheckpoints(checkpointsToFilter, checkpointsFiltered)
 		txFilter = f
 		txFilterTask, _ = gotask.NewTask("tx_filter", txFilter.Task)
 
@@ -332,17 +414,27 @@ func main() {
 		}
 	}
 
+	// signals to pick up fresh work immediately
+	wakeupBus := wakeup.New()
+
 	// tx confirmer
 	var txConfirmer *txconfirmer.Confirmer
 	{
 		c, err := txconfirmer.New(
 			filteredTX,
+			parsedBlockChan,
+			reorgChan,
+			latestBlockID,
+			conf.Confirmations,
 			dao,
+			alerter,
 			logger.WithField("task", "tx_confirmer"),
 		)
 		if err != nil {
 			logger.WithError(err).Fatal("Failed to setup transaction confirmer")
 		}
+		c.AddWakeup(wakeupBus)
+		c.AddCheckpoints(checkpointsToFilter, checkpointsFiltered)
 
 		txConfirmer = c
 		txConfirmerTask, _ = gotask.NewTask("tx_confirmer", txConfirmer.Task)
@@ -359,15 +451,113 @@ func main() {
 		if err != nil {
 			logger.WithError(err).Fatal("Failed to setup API")
 		}
+		a.AddWakeup(wakeupBus)
+
+		// spending policies
+		if len(conf.Policies) > 0 {
+			parse := func(v string) (*amount.Amount, error) {
+				if v == "" {
+					return nil, nil
+				}
+				return amount.FromString(v)
+			}
+
+			policies := make(map[string]*serviceAPI.Policy)
+			for service, p := range conf.Policies {
+				policy := &serviceAPI.Policy{
+					Limits: make(map[mint.Token]serviceAPI.Limit),
+					Hold:   p.Hold,
+				}
+				for t, l := range p.Limits {
+					token, err := mint.ParseToken(t)
+					if err != nil {
+						logger.WithError(err).Fatalf("Invalid token in %v spending policy", service)
+					}
+					perRequest, err := parse(l.PerRequest)
+					if err != nil {
+						logger.WithError(err).Fatalf("Invalid per request limit in %v spending policy", service)
+					}
+					perHour, err := parse(l.PerHour)
+					if err != nil {
+						logger.WithError(err).Fatalf("Invalid per hour limit in %v spending policy", service)
+					}
+					perDay, err := parse(l.PerDay)
+					if err != nil {
+						logger.WithError(err).Fatalf("Invalid per day limit in %v spending policy", service)
+					}
+					policy.Limits[token] = serviceAPI.Limit{
+						PerRequest: perRequest,
+						PerHour:    perHour,
+						PerDay:     perDay,
+					}
+				}
+				policies[service] = policy
+			}
+			a.AddPolicies(policies)
+		}
+
+		// manual approval
+		if conf.Approval.Gold != "" || conf.Approval.MNT != "" {
+			approval := &serviceAPI.Approval{
+				Thresholds: make(map[mint.Token]*amount.Amount),
+				Approvers:  conf.Approval.Approvers,
+			}
+			if conf.Approval.Gold != "" {
+				v, err := amount.FromString(conf.Approval.Gold)
+				if err != nil {
+					logger.WithError(err).Fatal("Invalid GOLD approval threshold")
+				}
+				approval.Thresholds[mint.TokenGOLD] = v
+			}
+			if conf.Approval.MNT != "" {
+				v, err := amount.FromString(conf.Approval.MNT)
+				if err != nil {
+					logger.WithError(err).Fatal("Invalid MNT approval threshold")
+				}
+				approval.Thresholds[mint.TokenMNT] = v
+			}
+			if len(conf.Approval.Admins) == 0 || conf.API.HTTP.Port == 0 {
+				logger.Warn("Sendings awaiting approval could not be approved without admins and HTTP transport")
+			}
+			if conf.Approval.Approvers > uint(len(conf.Approval.Admins)) {
+				logger.Fatal("Number of approvers exceeds number of admins")
+			}
+			a.AddApproval(approval)
+		}
+
 		api = a
 	}
 
+	// api authentication
+	var apiAuth *auth.Keys
+	if len(conf.API.Auth.Services) > 0 {
+		for service, secret := range conf.API.Auth.Services {
+			if secret == "" {
+				logger.Fatalf("Empty API secret of service %v", service)
+			}
+		}
+		apiAuth = auth.New(conf.API.Auth.Services, time.Second*time.Duration(conf.API.Auth.Window))
+	}
+
 	// nats transport
 	var natsTransport *serviceNats.Nats
 	if conf.API.Nats.URL != "" {
+		opts, err := secure.Nats{
+			TLS:   conf.API.Nats.TLS.Enabled,
+			CA:    conf.API.Nats.TLS.CA,
+			Cert:  conf.API.Nats.TLS.Cert,
+			Key:   conf.API.Nats.TLS.Key,
+			Creds: conf.API.Nats.Creds,
+			NKey:  conf.API.Nats.NKey,
+		}.Options()
+		if err != nil {
+			logger.WithError(err).Fatal("Failed to setup Nats security")
+		}
+
 		svc, cls, err := serviceNats.New(
 			conf.API.Nats.URL,
 			formatPrefix(conf.API.Nats.Prefix, "."),
+			opts,
 			api,
 			logger.WithField("task", "nats"),
 		)
@@ -376,6 +566,10 @@ func main() {
 		}
 		defer cls()
 
+		if apiAuth != nil {
+			svc.AddAuth(apiAuth)
+		}
+
 		natsTransport = svc
 		natsTransportTask, _ = gotask.NewTask("nats", svc.Task)
 	}
@@ -392,6 +586,33 @@ func main() {
 			logger.WithError(err).Fatal("Failed to setup HTTP transport")
 		}
 
+		if conf.API.HTTP.TLS.Cert != "" || conf.API.HTTP.TLS.Key != "" || conf.API.HTTP.TLS.ClientCA != "" {
+			c, err := secure.ServerTLS(conf.API.HTTP.TLS.Cert, conf.API.HTTP.TLS.Key, conf.API.HTTP.TLS.ClientCA)
+			if err != nil {
+				logger.WithError(err).Fatal("Failed to setup HTTP TLS")
+			}
+			svc.AddTLS(c)
+		}
+
+		if apiAuth != nil {
+			svc.AddAuth(apiAuth)
+		}
+
+		// admins by token
+		if len(conf.Approval.Admins) > 0 {
+			tokens := make(map[string]string)
+			for name, token := range conf.Approval.Admins {
+				if token == "" {
+					logger.Fatalf("Empty token of admin %v", name)
+				}
+				if _, ok := tokens[token]; ok {
+					logger.Fatalf("Token of admin %v is not unique", name)
+				}
+				tokens[token] = name
+			}
+			svc.AddAdmins(tokens)
+		}
+
 		httpTransport = svc
 		httpTransportTask, _ = gotask.NewTask("http", svc.Task)
 	}
@@ -409,12 +630,22 @@ func main() {
 		n, err := notifier.New(
 			dao,
 			natsIface, httpIface,
+			txConfirmer,
+			alerter,
 			logger.WithField("task", "notifier"),
 		)
 		if err != nil {
 			logger.WithError(err).Fatal("Failed to setup notifier")
 		}
 
+		retryPolicies, err := retry.FromConfig(&conf.NotifyRetry)
+		if err != nil {
+			logger.WithError(err).Fatal("Failed to setup notification retry policies")
+		}
+		n.AddRetry(retryPolicies)
+		n.AddWorkers(conf.NotifyWorkers, conf.NotifyHostWorkers)
+		n.AddWakeup(wakeupBus)
+
 		notifierTask, _ = gotask.NewTask("notifier", n.Task)
 	}
 
@@ -425,23 +656,70 @@ func main() {
 			rpcPool,
 			dao,
 			senderSigners,
+			time.Second*time.Duration(conf.Resync),
+			alerter,
 			logger.WithField("task", "tx_signer"),
 		)
 		if err != nil {
 			logger.WithError(err).Fatal("Failed to setup transaction signer")
 		}
+		s.AddWakeup(wakeupBus)
 
-		txSigner = s
-		txSignerTask, _ = gotask.NewTask("tx_signer", s.Task)
-	}
+		// low balance monitoring
+		{
+			parse := func(v, def string) (*amount.Amount, error) {
+				if v == "" {
+					v = def
+				}
+				if v == "" {
+					return nil, nil
+				}
+				return amount.FromString(v)
+			}
 
-	// latest block ID chan consumer
-	{
-		go func() {
-			for {
-				_ = <-parsedBlockChan
+			gold, err := parse(conf.LowBalance.Gold, "")
+			if err != nil {
+				logger.WithError(err).Fatal("Invalid GOLD low balance threshold")
+			}
+			mnt, err := parse(conf.LowBalance.MNT, "")
+			if err != nil {
+				logger.WithError(err).Fatal("Invalid MNT low balance threshold")
+			}
+
+			lb := &txsigner.LowBalance{
+				Default:     txsigner.Threshold{Gold: gold, MNT: mnt},
+				Thresholds:  make(map[mint.PublicKey]txsigner.Threshold),
+				CallbackURL: conf.LowBalance.Callback,
+			}
+			for k, w := range conf.LowBalance.Wallets {
+				pub, err := mint.ParsePublicKey(k)
+				if err != nil {
+					logger.WithError(err).Fatalf("Invalid low balance wallet %v", k)
+				}
+				gold, err := parse(w.Gold, conf.LowBalance.Gold)
+				if err != nil {
+					logger.WithError(err).Fatalf("Invalid GOLD low balance threshold for %v", pub.StringMask())
+				}
+				mnt, err := parse(w.MNT, conf.LowBalance.MNT)
+				if err != nil {
+					logger.WithError(err).Fatalf("Invalid MNT low balance threshold for %v", pub.StringMask())
+				}
+				lb.Thresholds[pub] = txsigner.Threshold{Gold: gold, MNT: mnt}
+			}
+			if natsTransport != nil {
+				lb.Nats = natsTransport
+			}
+			if httpTransport != nil {
+				lb.HTTP = httpTransport
+				if conf.LowBalance.Secret != "" {
+					httpTransport.AddLowBalanceSecret(conf.LowBalance.Secret)
+				}
 			}
-		}()
+			s.AddLowBalance(lb)
+		}
+
+		txSigner = s
+		txSignerTask, _ = gotask.NewTask("tx_signer", s.Task)
 	}
 
 	// metrics server
@@ -505,7 +783,27 @@ func main() {
 			}
 			blockObserver.AddMetrics(&m)
 			if blockRanger != nil {
-				blockRanger.AddMetrics(&m)
+				rm := blockranger.Metrics{
+					Remaining: promauto.NewGauge(prometheus.GaugeOpts{
+						Name:      "blockranger_remaining",
+						Help:      "Block ranger remaining blocks count",
+						Namespace: ns,
+						Subsystem: ss,
+					}),
+					ETA: promauto.NewGauge(prometheus.GaugeOpts{
+						Name:      "blockranger_eta",
+						Help:      "Block ranger estimated time to complete (seconds)",
+						Namespace: ns,
+						Subsystem: ss,
+					}),
+					WorkerBlocks: promauto.NewCounterVec(prometheus.CounterOpts{
+						Name:      "blockranger_worker_blocks",
+						Help:      "Block ranger fetched blocks count per worker",
+						Namespace: ns,
+						Subsystem: ss,
+					}, []string{"worker"}),
+				}
+				blockRanger.AddMetrics(&m, &rm)
 			}
 		}
 
@@ -551,15 +849,38 @@ func main() {
 		metricsTask, _ = gotask.NewTask("metrics", m.Task)
 	}
 
+	// closed on termination, interrupts signers reloading
+	stopping := make(chan struct{})
+
 	// handle termination signal
 	go func() {
 		sigchan := make(chan os.Signal, 1)
 		signal.Notify(sigchan, syscall.SIGINT, syscall.SIGTERM)
 		<-sigchan
 		logger.Info("Stop signal received")
+		close(stopping)
 		onStop()
 	}()
 
+	// handle reload signal
+	go func() {
+		loaded := make(map[mint.PublicKey]struct{})
+		for _, s := range senderSigners {
+			loaded[s.PublicKey()] = struct{}{}
+		}
+		sigchan := make(chan os.Signal, 1)
+		signal.Notify(sigchan, syscall.SIGHUP)
+		for {
+			select {
+			case <-sigchan:
+				logger.Info("Reload signal received")
+				reloadSigners(*argConfigFile, keystorePassword, dao, txSigner, loaded, walletToTrack, stopping)
+			case <-stopping:
+				return
+			}
+		}
+	}()
+
 	group = gotask.NewGroup("main")
 	group.Log(tasksLogger)
 	tasks := []*gotask.Task{
@@ -593,6 +914,156 @@ func main() {
 	logger.Info("Graceful stop")
 }
 
+// loadSigners makes signers from private keys in Base58, keystore files and remote signer wallets
+func loadSigners(conf *config, keystorePassword string) ([]keys.Signer, error) {
+	list := make([]keys.Signer, 0)
+	for i, k := range conf.Wallets {
+		pvt, err := mint.ParsePrivateKey(k)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sender private key at index %v", i)
+		}
+		list = append(list, keys.NewLocal(pvt))
+	}
+	for _, f := range conf.Keystore.Files {
+		l, err := keys.LoadKeystore(f, keystorePassword)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unlock keystore file %v: %v", f, err)
+		}
+		list = append(list, l)
+	}
+	for i, k := range conf.RemoteSigner.Wallets {
+		pub, err := mint.ParsePublicKey(k)
+		if err != nil {
+			return nil, fmt.Errorf("invalid remote signer public key at index %v", i)
+		}
+		if conf.RemoteSigner.URL == "" {
+			return nil, fmt.Errorf("remote signer URL is not specified")
+		}
+		if conf.RemoteSigner.Secret == "" {
+			return nil, fmt.Errorf("remote signer secret is not specified")
+		}
+		list = append(list, keys.NewRemote(conf.RemoteSigner.URL, conf.RemoteSigner.Secret, pub))
+	}
+	if len(list) == 0 {
+		return nil, fmt.Errorf("add at least one sender private key, keystore file or remote signer wallet")
+	}
+	return list, nil
+}
+
+// keystorePasswordEnv gets the name of env var containing keystore password
+func keystorePasswordEnv(conf *config) string {
+	if conf.Keystore.PasswordEnv != "" {
+		return conf.Keystore.PasswordEnv
+	}
+	return "SENDER_KEYSTORE_PASSWORD"
+}
+
+// makeKeystore asks for a private key and a password and writes encrypted keystore file
+func makeKeystore(file string) error {
+	k, err := readPassword("Private key (Base58): ")
+	if err != nil {
+		return err
+	}
+	pvt, err := mint.ParsePrivateKey(strings.TrimSpace(k))
+	if err != nil {
+		return fmt.Errorf("invalid private key")
+	}
+	pass, err := readPassword("Password: ")
+	if err != nil {
+		return err
+	}
+	pass2, err := readPassword("Repeat password: ")
+	if err != nil {
+		return err
+	}
+	if pass != pass2 {
+		return fmt.Errorf("passwords do not match")
+	}
+	b, err := keys.EncryptKey(pvt, pass)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(file, b, 0600); err != nil {
+		return err
+	}
+	fmt.Printf("Keystore file for %v is saved to %v\n", pvt.PublicKey().String(), file)
+	return nil
+}
+
+// readPassword reads a line from the terminal without echo
+func readPassword(prompt string) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !terminal.IsTerminal(fd) {
+		return "", fmt.Errorf("stdin is not a terminal")
+	}
+	fmt.Fprint(os.Stderr, prompt)
+	b, err := terminal.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// reloadSigners reads signers from the config file and passes them to the transaction signer.
+// New signers are saved to DB and added to the transactions filter, missing ones are drained.
+// Keystore password is taken from the env var at first, the one entered at launch is used otherwise.
+// Loaded contains public keys that are already tracked and is updated with the new ones
+func reloadSigners(file, keystorePassword string, dao db.DAO, txSigner *txsigner.Signer, loaded map[mint.PublicKey]struct{}, track chan<- mint.PublicKey, stop <-chan struct{}) {
+	var conf config
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		logger.WithError(err).Error("Failed to read config file")
+		return
+	}
+	if err := yaml.Unmarshal(b, &conf); err != nil {
+		logger.WithError(err).Error("Failed to parse config file")
+		return
+	}
+
+	if len(conf.Keystore.Files) > 0 {
+		if v, ok := os.LookupEnv(keystorePasswordEnv(&conf)); ok {
+			keystorePassword = v
+		} else if keystorePassword == "" {
+			logger.Errorf("Failed to reload senders: keystore password is unknown, set it via %v env var", keystorePasswordEnv(&conf))
+			return
+		}
+	}
+
+	// validate the whole set before applying anything
+	list, err := loadSigners(&conf, keystorePassword)
+	if err != nil {
+		logger.WithError(err).Error("Failed to load senders")
+		return
+	}
+
+	fresh := make([]mint.PublicKey, 0)
+	for _, s := range list {
+		if _, ok := loaded[s.PublicKey()]; ok {
+			continue
+		}
+		if err := dao.PutWallet(&types.Wallet{
+			PublicKey: s.PublicKey(),
+		}); err != nil {
+			logger.WithError(err).Error("Failed to save signer's address to DB")
+			return
+		}
+		fresh = append(fresh, s.PublicKey())
+	}
+
+	txSigner.UpdateSigners(list)
+
+	for _, pub := range fresh {
+		select {
+		case track <- pub:
+			loaded[pub] = struct{}{}
+		case <-stop:
+			return
+		}
+	}
+	logger.Infof("Reloaded %v signers, %v new", len(list), len(fresh))
+}
+
 func onStop() {
 	stopWait(txSignerTask)
 	stopWait(notifierTask)
@@ -618,12 +1089,30 @@ type config struct {
 	API struct {
 		HTTP struct {
 			Port uint `yaml:"port"`
+			TLS  struct {
+				Cert     string `yaml:"cert"`
+				Key      string `yaml:"key"`
+				ClientCA string `yaml:"client_ca"`
+			} `yaml:"tls"`
 		} `yaml:"http"`
 
 		Nats struct {
 			URL    string `yaml:"url"`
 			Prefix string `yaml:"prefix"`
+			TLS    struct {
+				Enabled bool   `yaml:"enabled"`
+				CA      string `yaml:"ca"`
+				Cert    string `yaml:"cert"`
+				Key     string `yaml:"key"`
+			} `yaml:"tls"`
+			Creds string `yaml:"creds"`
+			NKey  string `yaml:"nkey"`
 		} `yaml:"nats"`
+
+		Auth struct {
+			Window   uint              `yaml:"window"`
+			Services map[string]string `yaml:"services"`
+		} `yaml:"auth"`
 	} `yaml:"api"`
 
 	DB struct {
@@ -632,10 +1121,64 @@ type config struct {
 		Prefix string `yaml:"prefix"`
 	} `yaml:"db"`
 
-	Metrics      uint     `yaml:"metrics"`
-	GCloudAlerts bool     `yaml:"gcloud_alerts"`
-	Nodes        []string `yaml:"nodes"`
-	Wallets      []string `yaml:"wallets"`
+	Keystore struct {
+		PasswordEnv string   `yaml:"password_env"`
+		Files       []string `yaml:"files"`
+	} `yaml:"keystore"`
+
+	RemoteSigner struct {
+		URL     string   `yaml:"url"`
+		Secret  string   `yaml:"secret"`
+		Wallets []string `yaml:"wallets"`
+	} `yaml:"remote_signer"`
+
+	LowBalance struct {
+		Callback string `yaml:"callback"`
+		Secret   string `yaml:"secret"`
+		Gold     string `yaml:"gold"`
+		MNT      string `yaml:"mnt"`
+		Wallets  map[string]struct {
+			Gold string `yaml:"gold"`
+			MNT  string `yaml:"mnt"`
+		} `yaml:"wallets"`
+	} `yaml:"low_balance"`
+
+	Policies map[string]struct {
+		Hold   bool `yaml:"hold"`
+		Limits map[string]struct {
+			PerRequest string `yaml:"per_request"`
+			PerHour    string `yaml:"per_hour"`
+			PerDay     string `yaml:"per_day"`
+		} `yaml:"limits"`
+	} `yaml:"policies"`
+
+	Approval struct {
+		Gold      string            `yaml:"gold"`
+		MNT       string            `yaml:"mnt"`
+		Approvers uint              `yaml:"approvers"`
+		Admins    map[string]string `yaml:"admins"`
+	} `yaml:"approval"`
+
+	NotifyRetry retry.Config `yaml:"notify_retry"`
+
+	Alerts struct {
+		Webhook  string `yaml:"webhook"`
+		Slack    string `yaml:"slack"`
+		Telegram struct {
+			Token  string `yaml:"token"`
+			ChatID string `yaml:"chat_id"`
+		} `yaml:"telegram"`
+	} `yaml:"alerts"`
+
+	Metrics           uint     `yaml:"metrics"`
+	GCloudAlerts      bool     `yaml:"gcloud_alerts"`
+	Nodes             []string `yaml:"nodes"`
+	Wallets           []string `yaml:"wallets"`
+	Confirmations     uint16   `yaml:"confirmations"`
+	RangerWorkers     uint16   `yaml:"ranger_workers"`
+	Resync            uint     `yaml:"resync"`
+	NotifyWorkers     uint16   `yaml:"notify_workers"`
+	NotifyHostWorkers uint16   `yaml:"notify_host_workers"`
 }
 
 // ---
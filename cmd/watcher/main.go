@@ -18,22 +18,29 @@ import (
 	mint "github.com/void616/gm.mint"
 	"github.com/void616/gm.mint.rpc/request"
 	"github.com/void616/gm.mint.sender/internal/alert"
+	"github.com/void616/gm.mint.sender/internal/auth"
 	"github.com/void616/gm.mint.sender/internal/metrics"
 	"github.com/void616/gm.mint.sender/internal/mint/blockobserver"
 	"github.com/void616/gm.mint.sender/internal/mint/blockparser"
 	"github.com/void616/gm.mint.sender/internal/mint/blockranger"
 	"github.com/void616/gm.mint.sender/internal/mint/rpcpool"
 	"github.com/void616/gm.mint.sender/internal/mint/txfilter"
+	"github.com/void616/gm.mint.sender/internal/retry"
+	"github.com/void616/gm.mint.sender/internal/secure"
 	"github.com/void616/gm.mint.sender/internal/version"
+	"github.com/void616/gm.mint.sender/internal/wakeup"
 	serviceAPI "github.com/void616/gm.mint.sender/internal/watcher/api"
 	serviceHTTP "github.com/void616/gm.mint.sender/internal/watcher/api/http"
 	apiModels "github.com/void616/gm.mint.sender/internal/watcher/api/model"
 	serviceNats "github.com/void616/gm.mint.sender/internal/watcher/api/nats"
 	"github.com/void616/gm.mint.sender/internal/watcher/db"
 	"github.com/void616/gm.mint.sender/internal/watcher/db/mysql"
+	"github.com/void616/gm.mint.sender/internal/watcher/db/postgres"
+	"github.com/void616/gm.mint.sender/internal/watcher/db/sqlite"
 	"github.com/void616/gm.mint.sender/internal/watcher/db/types"
 	"github.com/void616/gm.mint.sender/internal/watcher/notifier"
 	"github.com/void616/gm.mint.sender/internal/watcher/txsaver"
+	"github.com/void616/gm.mint.sender/internal/watcher/watermark"
 	"github.com/void616/gm.mint/transaction"
 	"github.com/void616/gotask"
 	"gopkg.in/yaml.v2"
@@ -119,18 +126,33 @@ func main() {
 	// alerter
 	var alerter alert.Alerter
 	{
+		alerters := make([]alert.Alerter, 0)
 		if conf.GCloudAlerts && alert.OnGCE() {
 			a, cls, err := alert.NewGCloud("MintSender Watcher", logger.WithField("gce_alert", ""))
 			if err != nil {
 				logger.WithError(err).Fatal("Failed to setup Google Cloud alerts")
 			}
 			defer cls()
-			alerter = a
-		} else {
+			alerters = append(alerters, a)
+		}
+		if conf.Alerts.Webhook != "" {
+			alerters = append(alerters, alert.NewWebhook("MintSender Watcher", conf.Alerts.Webhook, logger.WithField("webhook_alert", "")))
+		}
+		if conf.Alerts.Slack != "" {
+			alerters = append(alerters, alert.NewSlack("MintSender Watcher", conf.Alerts.Slack, logger.WithField("slack_alert", "")))
+		}
+		if conf.Alerts.Telegram.Token != "" {
+			alerters = append(alerters, alert.NewTelegram("MintSender Watcher", conf.Alerts.Telegram.Token, conf.Alerts.Telegram.ChatID, logger.WithField("telegram_alert", "")))
+		}
+		switch len(alerters) {
+		case 0:
 			alerter = alert.NewLogrus(logger.WithField("alert", ""))
+		case 1:
+			alerter = alerters[0]
+		default:
+			alerter = alert.NewMulti(alerters...)
 		}
 	}
-	_ = alerter
 
 	// database
 	var dao db.DAO
@@ -152,6 +174,28 @@ func main() {
 			db.DB.DB().SetConnMaxLifetime(time.Second * 30)
 			db.DB.LogMode(logger.Level >= logrus.TraceLevel)
 			dao = db
+		case "postgres":
+			db, err := postgres.New(conf.DB.DSN, conf.DB.Prefix)
+			if err != nil {
+				logger.WithError(err).Fatal("Failed to setup DB")
+			}
+			defer db.Close()
+			db.DB.DB().SetMaxOpenConns(8)
+			db.DB.DB().SetMaxIdleConns(1)
+			db.DB.DB().SetConnMaxLifetime(time.Second * 30)
+			db.DB.LogMode(logger.Level >= logrus.TraceLevel)
+			dao = db
+		case "sqlite":
+			db, err := sqlite.New(conf.DB.DSN, conf.DB.Prefix)
+			if err != nil {
+				logger.WithError(err).Fatal("Failed to setup DB")
+			}
+			defer db.Close()
+			db.DB.DB().SetMaxOpenConns(1)
+			db.DB.LogMode(logger.Level >= logrus.TraceLevel)
+			dao = db
+		default:
+			logger.Fatalf("Unsupported DB driver %q", conf.DB.Driver)
 		}
 
 		if !dao.Available() {
@@ -183,6 +227,10 @@ func main() {
 	var parsedBlockChan = make(chan *big.Int)
 	defer close(parsedBlockChan)
 
+	// carries blockchain reorganization events
+	var reorgChan = make(chan *blockparser.Reorg)
+	defer close(reorgChan)
+
 	// get latest block ID (network)
 	var latestBlockID = new(big.Int)
 	{
@@ -218,6 +266,16 @@ func main() {
 			latestParsedBlockID.Set(latestBlockID)
 			dao.PutSetting(types.SettingLatestBlock, latestParsedBlockID.String())
 		}
+		if latestParsedBlockID.Cmp(latestBlockID) > 0 {
+			logger.Warnf("Latest parsed block ID is greater than current latest block: %v > %v. Blockchain reset, deleting incomings", latestParsedBlockID.String(), latestBlockID.String())
+			if err := dao.DeleteIncomings(latestBlockID); err != nil {
+				logger.WithError(err).Fatal("Failed to delete incomings")
+			}
+			latestParsedBlockID.Set(latestBlockID)
+			if err := dao.PutSetting(types.SettingLatestBlock, latestParsedBlockID.String()); err != nil {
+				logger.WithError(err).Fatal("Failed to save latest parsed block ID")
+			}
+		}
 	}
 
 	// block ID, explicitly set via args, to parse from
@@ -237,6 +295,9 @@ func main() {
 	var filteredTX = make(chan *blockparser.Transaction, 256)
 	defer close(filteredTX)
 
+	// carries latest completed block ID through tx filter and tx saver to ensure its transactions are saved
+	var checkpointsToFilter, checkpointsToSaver, checkpointsSaved = make(chan *big.Int, 1), make(chan *big.Int, 1), make(chan *big.Int, 1)
+
 	// carries public keys of wallets to add/remove from transactions filter
 	var walletToTrack, walletToUntrack = make(chan mint.PublicKey, 256), make(chan mint.PublicKey, 256)
 	defer close(walletToTrack)
@@ -254,6 +315,8 @@ func main() {
 			rpcPool,
 			parsedTX,
 			parsedBlockChan,
+			reorgChan,
+			alerter,
 			logger.WithField("task", "block_observer"),
 		)
 		if err != nil {
@@ -279,9 +342,11 @@ func main() {
 			b, err := blockranger.New(
 				from,
 				latestBlockID,
+				conf.RangerWorkers,
 				rpcPool,
 				parsedTX,
 				parsedBlockChan,
+				alerter,
 				logger.WithField("task", "block_ranger"),
 			)
 			if err != nil {
@@ -295,9 +360,14 @@ func main() {
 	// tx filter
 	var txFilter *txfilter.Filter
 	{
-		// type/direction filter
+		// type/direction filter (subscriptions are checked by tx saver)
 		filter := func(typ transaction.Code, outgoing bool) bool {
-			return typ == transaction.TransferAssetTx && !outgoing
+			for _, t := range types.WatchableTypes {
+				if t == typ {
+					return true
+				}
+			}
+			return false
 		}
 
 		f, err := txfilter.New(
@@ -311,10 +381,14 @@ func main() {
 		if err != nil {
 			logger.WithError(err).Fatal("Failed to setup transaction filter")
 		}
+		f.AddCheckpoints(checkpointsToFilter, checkpointsToSaver)
 		txFilter = f
 		txFilterTask, _ = gotask.NewTask("tx_filter", txFilter.Task)
 	}
 
+	// signals to pick up fresh work immediately
+	wakeupBus := wakeup.New()
+
 	// tx saver
 	var txSaver *txsaver.Saver
 	{
@@ -323,11 +397,14 @@ func main() {
 			walletSubs,
 			walletToUntrack,
 			dao,
+			alerter,
 			logger.WithField("task", "tx_saver"),
 		)
 		if err != nil {
 			logger.WithError(err).Fatal("Failed to setup transaction saver")
 		}
+		s.AddWakeup(wakeupBus)
+		s.AddCheckpoints(checkpointsToSaver, checkpointsSaved)
 
 		txSaver = s
 		txSaverTask, _ = gotask.NewTask("tx_saver", txSaver.Task)
@@ -345,6 +422,7 @@ func main() {
 		if err != nil {
 			logger.WithError(err).Fatal("Failed to setup API")
 		}
+		a.AddWakeup(wakeupBus)
 		api = a
 	}
 
@@ -356,16 +434,40 @@ func main() {
 		}
 		for _, w := range wallets {
 			txFilter.AddWallet(w.PublicKey)
-			txSaver.AddWalletSubs(w.PublicKey, w.Services...)
+			txSaver.AddWalletSubs(w)
 		}
 	}
 
+	// api authentication
+	var apiAuth *auth.Keys
+	if len(conf.API.Auth.Services) > 0 {
+		for service, secret := range conf.API.Auth.Services {
+			if secret == "" {
+				logger.Fatalf("Empty API secret of service %v", service)
+			}
+		}
+		apiAuth = auth.New(conf.API.Auth.Services, time.Second*time.Duration(conf.API.Auth.Window))
+	}
+
 	// nats transport
 	var natsTransport *serviceNats.Nats
 	if conf.API.Nats.URL != "" {
+		opts, err := secure.Nats{
+			TLS:   conf.API.Nats.TLS.Enabled,
+			CA:    conf.API.Nats.TLS.CA,
+			Cert:  conf.API.Nats.TLS.Cert,
+			Key:   conf.API.Nats.TLS.Key,
+			Creds: conf.API.Nats.Creds,
+			NKey:  conf.API.Nats.NKey,
+		}.Options()
+		if err != nil {
+			logger.WithError(err).Fatal("Failed to setup Nats security")
+		}
+
 		n, cls, err := serviceNats.New(
 			conf.API.Nats.URL,
 			formatPrefix(conf.API.Nats.Prefix, "."),
+			opts,
 			api,
 			logger.WithField("task", "nats"),
 		)
@@ -374,6 +476,10 @@ func main() {
 		}
 		defer cls()
 
+		if apiAuth != nil {
+			n.AddAuth(apiAuth)
+		}
+
 		natsTransport = n
 		natsTransportTask, _ = gotask.NewTask("nats", n.Task)
 	}
@@ -390,6 +496,33 @@ func main() {
 			logger.WithError(err).Fatal("Failed to setup HTTP transport")
 		}
 
+		if conf.API.HTTP.TLS.Cert != "" || conf.API.HTTP.TLS.Key != "" || conf.API.HTTP.TLS.ClientCA != "" {
+			c, err := secure.ServerTLS(conf.API.HTTP.TLS.Cert, conf.API.HTTP.TLS.Key, conf.API.HTTP.TLS.ClientCA)
+			if err != nil {
+				logger.WithError(err).Fatal("Failed to setup HTTP TLS")
+			}
+			svc.AddTLS(c)
+		}
+
+		if apiAuth != nil {
+			svc.AddAuth(apiAuth)
+		}
+
+		// admins by token
+		if len(conf.Admins) > 0 {
+			tokens := make(map[string]string)
+			for name, token := range conf.Admins {
+				if token == "" {
+					logger.Fatalf("Empty token of admin %v", name)
+				}
+				if _, ok := tokens[token]; ok {
+					logger.Fatalf("Token of admin %v is not unique", name)
+				}
+				tokens[token] = name
+			}
+			svc.AddAdmins(tokens)
+		}
+
 		httpTransport = svc
 		httpTransportTask, _ = gotask.NewTask("http", svc.Task)
 	}
@@ -407,12 +540,21 @@ func main() {
 		n, err := notifier.New(
 			dao,
 			natsIface, httpIface,
+			alerter,
 			logger.WithField("task", "notifier"),
 		)
 		if err != nil {
 			logger.WithError(err).Fatal("Failed to setup notifier")
 		}
 
+		retryPolicies, err := retry.FromConfig(&conf.NotifyRetry)
+		if err != nil {
+			logger.WithError(err).Fatal("Failed to setup notification retry policies")
+		}
+		n.AddRetry(retryPolicies)
+		n.AddWorkers(conf.NotifyWorkers, conf.NotifyHostWorkers)
+		n.AddWakeup(wakeupBus)
+
 		notifierTask, _ = gotask.NewTask("notifier", n.Task)
 	}
 
@@ -477,7 +619,27 @@ func main() {
 			}
 			blockObserver.AddMetrics(&m)
 			if blockRanger != nil {
-				blockRanger.AddMetrics(&m)
+				rm := blockranger.Metrics{
+					Remaining: promauto.NewGauge(prometheus.GaugeOpts{
+						Name:      "blockranger_remaining",
+						Help:      "Block ranger remaining blocks count",
+						Namespace: ns,
+						Subsystem: ss,
+					}),
+					ETA: promauto.NewGauge(prometheus.GaugeOpts{
+						Name:      "blockranger_eta",
+						Help:      "Block ranger estimated time to complete (seconds)",
+						Namespace: ns,
+						Subsystem: ss,
+					}),
+					WorkerBlocks: promauto.NewCounterVec(prometheus.CounterOpts{
+						Name:      "blockranger_worker_blocks",
+						Help:      "Block ranger fetched blocks count per worker",
+						Namespace: ns,
+						Subsystem: ss,
+					}, []string{"worker"}),
+				}
+				blockRanger.AddMetrics(&m, &rm)
 			}
 		}
 
@@ -504,31 +666,110 @@ func main() {
 		metricsTask, _ = gotask.NewTask("metrics", m.Task)
 	}
 
-	// last parsed block ID saver
+	// last parsed block ID saver: blocks are completed by the observer and the ranger in any order,
+	// the latest block completed contiguously is saved once transactions of the blocks are saved
 	{
 		lastParsedBlockTask, _ = gotask.NewTask("blockid_saver", func(token *gotask.Token, arg ...interface{}) {
-			var oldid = arg[0].(*big.Int)
-			var newid = new(big.Int)
-			var save = func() {
-				if newid.Cmp(oldid) > 0 {
-					dao.PutSetting(types.SettingLatestBlock, newid.String())
-					oldid.Set(newid)
+			var saved = arg[0].(*big.Int)
+			var mark = watermark.New(saved)
+			var inflight = false
+			var checkpoint = func() {
+				if inflight || mark.Mark().Cmp(saved) <= 0 {
+					return
+				}
+				select {
+				case checkpointsToFilter <- mark.Mark():
+					inflight = true
+				default:
+				}
+			}
+			var save = func(id *big.Int) {
+				inflight = false
+				if id.Cmp(saved) > 0 {
+					if err := dao.PutSetting(types.SettingLatestBlock, id.String()); err != nil {
+						logger.WithError(err).Error("Failed to save latest parsed block ID")
+						return
+					}
+					saved.Set(id)
 				}
 			}
 			for !token.Stopped() {
 				select {
 				case id := <-parsedBlockChan:
-					if id.Cmp(newid) > 0 {
-						newid.Set(id)
-						if newid.Cmp(new(big.Int).Add(oldid, big.NewInt(10))) > 0 {
-							save()
+					if mark.Complete(id) && mark.Mark().Cmp(new(big.Int).Add(saved, big.NewInt(10))) > 0 {
+						checkpoint()
+					}
+				case id := <-checkpointsSaved:
+					save(id)
+				case r := <-reorgChan:
+					// wait for incomings still buffered in the tx filter and the tx saver
+					for sent, passed := false, false; !passed && !token.Stopped(); {
+						var out chan<- *big.Int
+						if !inflight && !sent {
+							out = checkpointsToFilter
+						}
+						select {
+						case out <- new(big.Int).Set(r.Fork):
+							sent = true
+						case id := <-checkpointsSaved:
+							switch {
+							case inflight && id.Cmp(r.Fork) <= 0:
+								// requested before the reorganization
+								save(id)
+							case inflight:
+								inflight = false
+							default:
+								passed = sent
+							}
+						case <-time.After(time.Second):
+						}
+					}
+					// delete incomings after the fork block
+					for !token.Stopped() {
+						if err := dao.DeleteIncomings(r.Fork); err != nil {
+							logger.WithError(err).WithField("fork", r.Fork.String()).Error("Failed to delete incomings")
+							token.Sleep(time.Second * 10)
+							continue
+						}
+						break
+					}
+					mark.Rollback(r.Fork)
+					if saved.Cmp(r.Fork) > 0 {
+						saved.Set(r.Fork)
+						for !token.Stopped() {
+							if err := dao.PutSetting(types.SettingLatestBlock, saved.String()); err != nil {
+								logger.WithError(err).WithField("fork", r.Fork.String()).Error("Failed to save latest parsed block ID")
+								token.Sleep(time.Second * 10)
+								continue
+							}
+							break
 						}
 					}
+					logger.WithField("fork", r.Fork.String()).Warn("Incomings after the fork block are deleted")
+					r.Done()
 				case <-time.After(time.Millisecond * 250):
-					save()
+					checkpoint()
 				}
 			}
-			save()
+
+			// the last checkpoint (tx filter and tx saver are still running)
+			timeout := time.After(time.Second * 10)
+			for out := false; !out; {
+				checkpoint()
+				if !inflight {
+					break
+				}
+				select {
+				case id := <-checkpointsSaved:
+					save(id)
+				case <-timeout:
+					logger.Warn("Failed to save latest parsed block ID in time")
+					out = true
+				}
+			}
+			if mark.Pending() > 0 {
+				logger.Warnf("Latest parsed block ID is saved as %v, %v blocks after the gap will be parsed again", saved.String(), mark.Pending())
+			}
 		}, new(big.Int).Set(latestParsedBlockID))
 	}
 
@@ -580,10 +821,10 @@ func onStop() {
 	stopWait(httpTransportTask)
 	stopWait(blockObserverTask)
 	stopWait(blockRangerTask)
+	stopWait(lastParsedBlockTask)
 	stopWait(txFilterTask)
 	stopWait(txSaverTask)
 	stopWait(metricsTask)
-	stopWait(lastParsedBlockTask)
 	group.Stop()
 }
 
@@ -599,12 +840,30 @@ type config struct {
 	API struct {
 		HTTP struct {
 			Port uint `yaml:"port"`
+			TLS  struct {
+				Cert     string `yaml:"cert"`
+				Key      string `yaml:"key"`
+				ClientCA string `yaml:"client_ca"`
+			} `yaml:"tls"`
 		} `yaml:"http"`
 
 		Nats struct {
 			URL    string `yaml:"url"`
 			Prefix string `yaml:"prefix"`
+			TLS    struct {
+				Enabled bool   `yaml:"enabled"`
+				CA      string `yaml:"ca"`
+				Cert    string `yaml:"cert"`
+				Key     string `yaml:"key"`
+			} `yaml:"tls"`
+			Creds string `yaml:"creds"`
+			NKey  string `yaml:"nkey"`
 		} `yaml:"nats"`
+
+		Auth struct {
+			Window   uint              `yaml:"window"`
+			Services map[string]string `yaml:"services"`
+		} `yaml:"auth"`
 	} `yaml:"api"`
 
 	DB struct {
@@ -613,9 +872,25 @@ type config struct {
 		Prefix string `yaml:"prefix"`
 	} `yaml:"db"`
 
-	Metrics      uint     `yaml:"metrics"`
-	GCloudAlerts bool     `yaml:"gcloud_alerts"`
-	Nodes        []string `yaml:"nodes"`
+	NotifyRetry retry.Config `yaml:"notify_retry"`
+
+	Admins map[string]string `yaml:"admins"`
+
+	Alerts struct {
+		Webhook  string `yaml:"webhook"`
+		Slack    string `yaml:"slack"`
+		Telegram struct {
+			Token  string `yaml:"token"`
+			ChatID string `yaml:"chat_id"`
+		} `yaml:"telegram"`
+	} `yaml:"alerts"`
+
+	Metrics           uint     `yaml:"metrics"`
+	GCloudAlerts      bool     `yaml:"gcloud_alerts"`
+	Nodes             []string `yaml:"nodes"`
+	RangerWorkers     uint16   `yaml:"ranger_workers"`
+	NotifyWorkers     uint16   `yaml:"notify_workers"`
+	NotifyHostWorkers uint16   `yaml:"notify_host_workers"`
 }
 
 // ---
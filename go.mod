@@ -9,8 +9,10 @@ require (
 	github.com/golang/protobuf v1.3.3
 	github.com/gorilla/mux v1.7.3
 	github.com/jinzhu/gorm v1.9.2
+	github.com/lib/pq v1.0.0
 	github.com/mattn/go-colorable v0.1.2 // indirect
 	github.com/mattn/go-isatty v0.0.9 // indirect
+	github.com/mattn/go-sqlite3 v1.10.0
 	github.com/nats-io/nats-server/v2 v2.1.4 // indirect
 	github.com/nats-io/nats.go v1.9.1
 	github.com/prometheus/client_golang v1.1.0
@@ -19,6 +21,7 @@ require (
 	github.com/void616/gm.mint.rpc v1.2.6
 	github.com/void616/gotask v1.0.2
 	go.opencensus.io v0.22.3 // indirect
+	golang.org/x/crypto v0.0.0-20190701094942-4def268fd1a4
 	golang.org/x/oauth2 v0.0.0-20200107190931-bf48bf16ab8d
 	google.golang.org/api v0.17.0
 	google.golang.org/genproto v0.0.0-20200212174721-66ed5ce911ce // indirect
@@ -0,0 +1,64 @@
+package alert
+
+import "time"
+
+var (
+	_ Alerter = &MultiAlerter{}
+)
+
+// MultiAlerter fans out alerts to a set of alerters
+type MultiAlerter struct {
+	alerters []Alerter
+	limiter  *timeLimiter
+}
+
+// NewMulti instance.
+// Limited alerts are limited once and then sent to the alerters as regular ones
+func NewMulti(alerters ...Alerter) *MultiAlerter {
+	return &MultiAlerter{
+		alerters: alerters,
+		limiter:  newTimeLimiter(),
+	}
+}
+
+// Info implementation
+func (a *MultiAlerter) Info(f string, arg ...interface{}) {
+	for _, v := range a.alerters {
+		v.Info(f, arg...)
+	}
+}
+
+// Warn implementation
+func (a *MultiAlerter) Warn(f string, arg ...interface{}) {
+	for _, v := range a.alerters {
+		v.Warn(f, arg...)
+	}
+}
+
+// Error implementation
+func (a *MultiAlerter) Error(f string, arg ...interface{}) {
+	for _, v := range a.alerters {
+		v.Error(f, arg...)
+	}
+}
+
+// LimitInfo implementation
+func (a *MultiAlerter) LimitInfo(max time.Duration, f string, arg ...interface{}) {
+	if a.limiter.limit(max, f, arg...) {
+		a.Info(f, arg...)
+	}
+}
+
+// LimitWarn implementation
+func (a *MultiAlerter) LimitWarn(max time.Duration, f string, arg ...interface{}) {
+	if a.limiter.limit(max, f, arg...) {
+		a.Warn(f, arg...)
+	}
+}
+
+// LimitError implementation
+func (a *MultiAlerter) LimitError(max time.Duration, f string, arg ...interface{}) {
+	if a.limiter.limit(max, f, arg...) {
+		a.Error(f, arg...)
+	}
+}
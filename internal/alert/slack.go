@@ -0,0 +1,81 @@
+package alert
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	_ Alerter = &SlackAlerter{}
+)
+
+// SlackAlerter sends alerts via Slack incoming webhook
+type SlackAlerter struct {
+	logger  *logrus.Entry
+	origin  string
+	url     string
+	limiter *timeLimiter
+}
+
+// NewSlack instance.
+// URL is an incoming webhook URL of the Slack app
+func NewSlack(origin, url string, logger *logrus.Entry) *SlackAlerter {
+	return &SlackAlerter{
+		logger:  logger,
+		origin:  origin,
+		url:     url,
+		limiter: newTimeLimiter(),
+	}
+}
+
+// Info implementation
+func (a *SlackAlerter) Info(f string, arg ...interface{}) {
+	a.send("info", f, arg...)
+}
+
+// Warn implementation
+func (a *SlackAlerter) Warn(f string, arg ...interface{}) {
+	a.send("warning", f, arg...)
+}
+
+// Error implementation
+func (a *SlackAlerter) Error(f string, arg ...interface{}) {
+	a.send("error", f, arg...)
+}
+
+// LimitInfo implementation
+func (a *SlackAlerter) LimitInfo(max time.Duration, f string, arg ...interface{}) {
+	if a.limiter.limit(max, f, arg...) {
+		a.Info(f, arg...)
+	}
+}
+
+// LimitWarn implementation
+func (a *SlackAlerter) LimitWarn(max time.Duration, f string, arg ...interface{}) {
+	if a.limiter.limit(max, f, arg...) {
+		a.Warn(f, arg...)
+	}
+}
+
+// LimitError implementation
+func (a *SlackAlerter) LimitError(max time.Duration, f string, arg ...interface{}) {
+	if a.limiter.limit(max, f, arg...) {
+		a.Error(f, arg...)
+	}
+}
+
+// ---
+
+func (a *SlackAlerter) send(level string, f string, arg ...interface{}) {
+	data := struct {
+		Text string `json:"text"`
+	}{
+		fmt.Sprintf("[%v] *%v*: %v", strings.ToUpper(level), a.origin, fmt.Sprintf(f, arg...)),
+	}
+	if err := postJSON(a.url, data); err != nil {
+		a.logger.WithError(err).Errorf("Failed to post message")
+	}
+}
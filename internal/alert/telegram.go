@@ -0,0 +1,85 @@
+package alert
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	_ Alerter = &TelegramAlerter{}
+)
+
+// TelegramAlerter sends alerts to a chat via Telegram Bot API
+type TelegramAlerter struct {
+	logger  *logrus.Entry
+	origin  string
+	token   string
+	chatID  string
+	limiter *timeLimiter
+}
+
+// NewTelegram instance.
+// Token is a bot token, chat ID is a chat (group, channel) the bot is a member of
+func NewTelegram(origin, token, chatID string, logger *logrus.Entry) *TelegramAlerter {
+	return &TelegramAlerter{
+		logger:  logger,
+		origin:  origin,
+		token:   token,
+		chatID:  chatID,
+		limiter: newTimeLimiter(),
+	}
+}
+
+// Info implementation
+func (a *TelegramAlerter) Info(f string, arg ...interface{}) {
+	a.send("info", f, arg...)
+}
+
+// Warn implementation
+func (a *TelegramAlerter) Warn(f string, arg ...interface{}) {
+	a.send("warning", f, arg...)
+}
+
+// Error implementation
+func (a *TelegramAlerter) Error(f string, arg ...interface{}) {
+	a.send("error", f, arg...)
+}
+
+// LimitInfo implementation
+func (a *TelegramAlerter) LimitInfo(max time.Duration, f string, arg ...interface{}) {
+	if a.limiter.limit(max, f, arg...) {
+		a.Info(f, arg...)
+	}
+}
+
+// LimitWarn implementation
+func (a *TelegramAlerter) LimitWarn(max time.Duration, f string, arg ...interface{}) {
+	if a.limiter.limit(max, f, arg...) {
+		a.Warn(f, arg...)
+	}
+}
+
+// LimitError implementation
+func (a *TelegramAlerter) LimitError(max time.Duration, f string, arg ...interface{}) {
+	if a.limiter.limit(max, f, arg...) {
+		a.Error(f, arg...)
+	}
+}
+
+// ---
+
+func (a *TelegramAlerter) send(level string, f string, arg ...interface{}) {
+	data := struct {
+		ChatID string `json:"chat_id"`
+		Text   string `json:"text"`
+	}{
+		a.chatID,
+		fmt.Sprintf("[%v] %v: %v", strings.ToUpper(level), a.origin, fmt.Sprintf(f, arg...)),
+	}
+	if err := postJSON("https://api.telegram.org/bot"+a.token+"/sendMessage", data); err != nil {
+		a.logger.WithError(err).Errorf("Failed to send message")
+	}
+}
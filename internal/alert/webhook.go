@@ -0,0 +1,85 @@
+package alert
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	_ Alerter = &WebhookAlerter{}
+)
+
+// WebhookAlerter posts alerts to an arbitrary HTTP endpoint as JSON
+type WebhookAlerter struct {
+	logger  *logrus.Entry
+	origin  string
+	url     string
+	limiter *timeLimiter
+}
+
+// NewWebhook instance.
+// Message is posted as JSON object with `from`, `level` and `message` fields
+func NewWebhook(origin, url string, logger *logrus.Entry) *WebhookAlerter {
+	return &WebhookAlerter{
+		logger:  logger,
+		origin:  origin,
+		url:     url,
+		limiter: newTimeLimiter(),
+	}
+}
+
+// Info implementation
+func (a *WebhookAlerter) Info(f string, arg ...interface{}) {
+	a.send("info", f, arg...)
+}
+
+// Warn implementation
+func (a *WebhookAlerter) Warn(f string, arg ...interface{}) {
+	a.send("warning", f, arg...)
+}
+
+// Error implementation
+func (a *WebhookAlerter) Error(f string, arg ...interface{}) {
+	a.send("error", f, arg...)
+}
+
+// LimitInfo implementation
+func (a *WebhookAlerter) LimitInfo(max time.Duration, f string, arg ...interface{}) {
+	if a.limiter.limit(max, f, arg...) {
+		a.Info(f, arg...)
+	}
+}
+
+// LimitWarn implementation
+func (a *WebhookAlerter) LimitWarn(max time.Duration, f string, arg ...interface{}) {
+	if a.limiter.limit(max, f, arg...) {
+		a.Warn(f, arg...)
+	}
+}
+
+// LimitError implementation
+func (a *WebhookAlerter) LimitError(max time.Duration, f string, arg ...interface{}) {
+	if a.limiter.limit(max, f, arg...) {
+		a.Error(f, arg...)
+	}
+}
+
+// ---
+
+func (a *WebhookAlerter) send(level string, f string, arg ...interface{}) {
+	data := struct {
+		From    string `json:"from"`
+		Level   string `json:"level"`
+		Message string `json:"message"`
+	}{
+		a.origin,
+		strings.ToLower(level),
+		fmt.Sprintf(f, arg...),
+	}
+	if err := postJSON(a.url, data); err != nil {
+		a.logger.WithError(err).Errorf("Failed to post message")
+	}
+}
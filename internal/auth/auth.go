@@ -0,0 +1,215 @@
+// Package auth authenticates API requests and signs callbacks with per-service HMAC-SHA256 secrets
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+const (
+	// HeaderTimestamp is HTTP header containing Unix time (seconds) of the request
+	HeaderTimestamp = "X-Timestamp"
+	// HeaderSignature is HTTP header containing hex encoded signature of the request
+	HeaderSignature = "X-Signature"
+	// HeaderNonce is HTTP header containing unique ID of the request chosen by the requestor
+	HeaderNonce = "X-Nonce"
+)
+
+// DefaultWindow is max difference between a request timestamp and local time
+const DefaultWindow = time.Minute * 5
+
+var (
+	// ErrUnknownService means service has no secret
+	ErrUnknownService = errors.New("unknown service")
+	// ErrTimestamp means request timestamp is missing or out of window
+	ErrTimestamp = errors.New("invalid timestamp")
+	// ErrSignature means request signature mismatch
+	ErrSignature = errors.New("invalid signature")
+	// ErrNonce means request nonce is missing
+	ErrNonce = errors.New("missing nonce")
+	// ErrReplay means the nonce is already used within the window
+	ErrReplay = errors.New("replayed request")
+)
+
+// Keys holds secrets of services
+type Keys struct {
+	secrets map[string][]byte
+	window  time.Duration
+	seenLck sync.Mutex
+	seen    map[string]time.Time
+	purged  time.Time
+}
+
+// New instance, secrets are mapped by service name, window is max age of a request (DefaultWindow if zero)
+func New(secrets map[string]string, window time.Duration) *Keys {
+	if window <= 0 {
+		window = DefaultWindow
+	}
+	k := &Keys{
+		secrets: make(map[string][]byte),
+		window:  window,
+		seen:    make(map[string]time.Time),
+	}
+	for service, secret := range secrets {
+		k.secrets[service] = []byte(secret)
+	}
+	return k
+}
+
+// Sign gets hex encoded HMAC-SHA256 of "timestamp.data" with the secret
+func Sign(secret []byte, timestamp int64, data []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte{'.'})
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// HTTPData gets data to sign for HTTP request or callback: "METHOD PATH\nNONCE\nBODY", empty path is "/"
+func HTTPData(method, path, nonce string, body []byte) []byte {
+	if path == "" {
+		path = "/"
+	}
+	data := make([]byte, 0, len(method)+len(path)+len(nonce)+len(body)+3)
+	data = append(data, method...)
+	data = append(data, ' ')
+	data = append(data, path...)
+	data = append(data, '\n')
+	data = append(data, nonce...)
+	data = append(data, '\n')
+	return append(data, body...)
+}
+
+// Verify checks the signature of the data made by the service and rejects a request with the same nonce within the window
+func (k *Keys) Verify(service string, timestamp int64, nonce string, data []byte, signature string) error {
+	if nonce == "" {
+		return ErrNonce
+	}
+	if err := k.VerifyQuery(service, timestamp, data, signature); err != nil {
+		return err
+	}
+	if !k.remember(service+"\n"+nonce, time.Unix(timestamp, 0).Add(k.window)) {
+		return ErrReplay
+	}
+	return nil
+}
+
+// VerifyQuery checks the signature of the data made by the service without replay detection.
+// It's for read-only requests, which are safe to repeat
+func (k *Keys) VerifyQuery(service string, timestamp int64, data []byte, signature string) error {
+	secret, ok := k.secrets[service]
+	if !ok {
+		return ErrUnknownService
+	}
+	if d := time.Since(time.Unix(timestamp, 0)); timestamp <= 0 || d > k.window || d < -k.window {
+		return ErrTimestamp
+	}
+	if !hmac.Equal([]byte(Sign(secret, timestamp, data)), []byte(signature)) {
+		return ErrSignature
+	}
+	return nil
+}
+
+// remember saves the key until it's expired, false means the key is already saved.
+// Keys are kept in memory, so replays are rejected by a single instance only
+func (k *Keys) remember(key string, expires time.Time) bool {
+	k.seenLck.Lock()
+	defer k.seenLck.Unlock()
+
+	// purge expired keys once a second
+	now := time.Now()
+	if now.Sub(k.purged) >= time.Second {
+		for key, exp := range k.seen {
+			if now.After(exp) {
+				delete(k.seen, key)
+			}
+		}
+		k.purged = now
+	}
+	if _, ok := k.seen[key]; ok {
+		return false
+	}
+	k.seen[key] = expires
+	return true
+}
+
+// VerifyHTTP checks the signature of HTTP request made by the service, the nonce is taken from the request header
+func (k *Keys) VerifyHTTP(r *http.Request, service string, body []byte) error {
+	timestamp, err := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+	if err != nil {
+		return ErrTimestamp
+	}
+	nonce := r.Header.Get(HeaderNonce)
+	return k.Verify(service, timestamp, nonce, HTTPData(r.Method, r.URL.Path, nonce, body), r.Header.Get(HeaderSignature))
+}
+
+// VerifyMessage checks the signature of Nats request made by the service.
+// Nats request has no nonce, so it's identified by the signature: a repeated request must be signed at another second.
+// Signature field of the request is cleared until return to get the request data signed by the service.
+func (k *Keys) VerifyMessage(service string, timestamp int64, signature *string, msg proto.Message) error {
+	data, err := messageData(signature, msg)
+	if err != nil {
+		return err
+	}
+	return k.Verify(service, timestamp, *signature, data, *signature)
+}
+
+// VerifyMessageQuery checks the signature of read-only Nats request made by the service without replay detection
+func (k *Keys) VerifyMessageQuery(service string, timestamp int64, signature *string, msg proto.Message) error {
+	data, err := messageData(signature, msg)
+	if err != nil {
+		return err
+	}
+	return k.VerifyQuery(service, timestamp, data, *signature)
+}
+
+// messageData marshals Nats request with empty signature field
+func messageData(signature *string, msg proto.Message) ([]byte, error) {
+	sig := *signature
+	*signature = ""
+	data, err := proto.Marshal(msg)
+	*signature = sig
+	return data, err
+}
+
+// SignMessage sets timestamp and signature fields of Nats request with the secret
+func SignMessage(secret []byte, timestamp *int64, signature *string, msg proto.Message) error {
+	*timestamp = time.Now().Unix()
+	*signature = ""
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	*signature = Sign(secret, *timestamp, data)
+	return nil
+}
+
+// SignCallback signs HTTP callback with the service secret, request is left unsigned if service has no secret
+func (k *Keys) SignCallback(r *http.Request, service string, body []byte) {
+	secret, ok := k.secrets[service]
+	if !ok {
+		return
+	}
+	SignHTTP(r, secret, body)
+}
+
+// SignHTTP sets timestamp, nonce and signature headers of HTTP request with the secret.
+// The request is signed in the same form VerifyHTTP checks
+func SignHTTP(r *http.Request, secret []byte, body []byte) {
+	timestamp := time.Now().Unix()
+	b := make([]byte, 16)
+	rand.Read(b)
+	nonce := hex.EncodeToString(b)
+	r.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	r.Header.Set(HeaderNonce, nonce)
+	r.Header.Set(HeaderSignature, Sign(secret, timestamp, HTTPData(r.Method, r.URL.Path, nonce, body)))
+}
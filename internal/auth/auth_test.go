@@ -0,0 +1,117 @@
+package auth
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestVerify(t *testing.T) {
+	k := New(map[string]string{"svc": "secret"}, 0)
+	data := HTTPData("POST", "/send", "n1", []byte("{}"))
+
+	now := time.Now().Unix()
+	sig := Sign([]byte("secret"), now, data)
+	if err := k.Verify("svc", now, "n1", data, sig); err != nil {
+		t.Fatal(err)
+	}
+
+	// same request again
+	if err := k.Verify("svc", now, "n1", data, sig); err != ErrReplay {
+		t.Fatalf("replay is accepted: %v", err)
+	}
+	// same nonce signed a second later
+	sig = Sign([]byte("secret"), now+1, data)
+	if err := k.Verify("svc", now+1, "n1", data, sig); err != ErrReplay {
+		t.Fatalf("replay is accepted: %v", err)
+	}
+	// no nonce
+	if err := k.Verify("svc", now+1, "", data, sig); err != ErrNonce {
+		t.Fatalf("request without nonce is accepted: %v", err)
+	}
+
+	// another nonce is another request
+	data = HTTPData("POST", "/send", "n2", []byte("{}"))
+	sig = Sign([]byte("secret"), now, data)
+	if err := k.Verify("svc", now, "n2", data, sig); err != nil {
+		t.Fatal(err)
+	}
+
+	// tampered
+	if err := k.Verify("svc", now+2, "n3", data, sig); err != ErrSignature {
+		t.Fatalf("tampered request is accepted: %v", err)
+	}
+	// out of window
+	old := time.Now().Add(-DefaultWindow * 2).Unix()
+	if err := k.Verify("svc", old, "n4", data, Sign([]byte("secret"), old, data)); err != ErrTimestamp {
+		t.Fatalf("stale request is accepted: %v", err)
+	}
+	if err := k.Verify("other", now, "n5", data, sig); err != ErrUnknownService {
+		t.Fatalf("unknown service is accepted: %v", err)
+	}
+}
+
+func TestReplayWindow(t *testing.T) {
+	k := New(map[string]string{"svc": "secret"}, 0)
+
+	if !k.remember("a", time.Now().Add(-time.Second)) || !k.remember("b", time.Now().Add(time.Minute)) {
+		t.Fatal("new key is rejected")
+	}
+	if k.remember("a", time.Now().Add(time.Minute)) || k.remember("b", time.Now().Add(time.Minute)) {
+		t.Fatal("key is accepted twice")
+	}
+
+	// expired key is accepted again once purged
+	k.purged = time.Time{}
+	if !k.remember("a", time.Now().Add(time.Minute)) {
+		t.Fatal("expired key is rejected")
+	}
+	if k.remember("b", time.Now().Add(time.Minute)) {
+		t.Fatal("unexpired key is accepted again")
+	}
+	if len(k.seen) != 2 {
+		t.Fatalf("%v keys are remembered", len(k.seen))
+	}
+}
+
+func TestHTTP(t *testing.T) {
+	k := New(map[string]string{"svc": "secret"}, 0)
+
+	// bodiless requests repeated within a second are accepted
+	for i := 0; i < 3; i++ {
+		r, _ := http.NewRequest("GET", "http://localhost/status/svc/1", nil)
+		SignHTTP(r, []byte("secret"), nil)
+		if err := k.VerifyHTTP(r, "svc", nil); err != nil {
+			t.Fatalf("request %v: %v", i, err)
+		}
+
+		// exact replay
+		if err := k.VerifyHTTP(r, "svc", nil); err != ErrReplay {
+			t.Fatalf("replay is accepted: %v", err)
+		}
+	}
+
+	// path and method are signed
+	r, _ := http.NewRequest("DELETE", "http://localhost/status/svc/1", nil)
+	SignHTTP(r, []byte("secret"), nil)
+	r.URL.Path = "/status/svc/2"
+	if err := k.VerifyHTTP(r, "svc", nil); err != ErrSignature {
+		t.Fatalf("tampered path is accepted: %v", err)
+	}
+	r, _ = http.NewRequest("DELETE", "http://localhost/status/svc/1", nil)
+	SignHTTP(r, []byte("secret"), nil)
+	r.Method = "GET"
+	if err := k.VerifyHTTP(r, "svc", nil); err != ErrSignature {
+		t.Fatalf("tampered method is accepted: %v", err)
+	}
+
+	// callback is signed in the same form
+	r, _ = http.NewRequest("POST", "http://localhost", nil)
+	k.SignCallback(r, "svc", []byte("{}"))
+	if err := k.VerifyHTTP(r, "svc", []byte("{}")); err != nil {
+		t.Fatal(err)
+	}
+	if err := k.VerifyHTTP(r, "svc", []byte("{1}")); err != ErrSignature {
+		t.Fatalf("tampered body is accepted: %v", err)
+	}
+}
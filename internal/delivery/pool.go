@@ -0,0 +1,179 @@
+// Package delivery runs notification deliveries concurrently keeping the order of deliveries to the same destination
+package delivery
+
+import (
+	"sync"
+)
+
+// Job is a delivery to a destination
+type Job struct {
+	// Destination of the delivery, jobs of the same destination are run one by one in order of submission
+	Destination string
+	// Host of the destination (optional), concurrent deliveries to the same host are limited
+	Host string
+	// Run delivers, false means the rest of destination's jobs are dropped (to be submitted again later)
+	Run func() bool
+}
+
+// Pool is a bounded pool of workers running jobs of distinct destinations in round-robin manner
+type Pool struct {
+	workers int
+	perHost int
+	lock    sync.Mutex
+	cond    *sync.Cond
+	queues  map[string][]Job
+	ready   []string
+	running map[string]bool
+	hosts   map[string]int
+	jobs    int
+	stopped bool
+	wg      sync.WaitGroup
+}
+
+// New Pool instance, perHost limits concurrent deliveries to the same host (unlimited if zero)
+func New(workers, perHost int) *Pool {
+	if workers <= 0 {
+		workers = 1
+	}
+	if perHost < 0 {
+		perHost = 0
+	}
+	p := &Pool{
+		workers: workers,
+		perHost: perHost,
+		queues:  make(map[string][]Job),
+		ready:   make([]string, 0),
+		running: make(map[string]bool),
+		hosts:   make(map[string]int),
+	}
+	p.cond = sync.NewCond(&p.lock)
+	return p
+}
+
+// Start workers
+func (p *Pool) Start() {
+	for i := 0; i < p.workers; i++ {
+		p.wg.Add(1)
+		go p.work()
+	}
+}
+
+// Stop workers and wait until running jobs are finished, queued jobs are dropped
+func (p *Pool) Stop() {
+	p.lock.Lock()
+	p.stopped = true
+	p.queues = make(map[string][]Job)
+	p.ready = p.ready[:0]
+	p.jobs = 0
+	p.cond.Broadcast()
+	p.lock.Unlock()
+	p.wg.Wait()
+}
+
+// Submit a job
+func (p *Pool) Submit(j Job) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.stopped {
+		return
+	}
+	q, ok := p.queues[j.Destination]
+	p.queues[j.Destination] = append(q, j)
+	p.jobs++
+	if !ok && !p.running[j.Destination] {
+		p.ready = append(p.ready, j.Destination)
+		p.cond.Signal()
+	}
+}
+
+// Destinations gets a list of destinations with queued or running jobs
+func (p *Pool) Destinations() []string {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	list := make([]string, 0, len(p.queues)+len(p.running))
+	for d := range p.queues {
+		list = append(list, d)
+	}
+	for d := range p.running {
+		if _, ok := p.queues[d]; !ok {
+			list = append(list, d)
+		}
+	}
+	return list
+}
+
+// Jobs gets a number of queued jobs
+func (p *Pool) Jobs() int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.jobs
+}
+
+// work runs jobs until the pool is stopped
+func (p *Pool) work() {
+	defer p.wg.Done()
+	for {
+		j, ok := p.next()
+		if !ok {
+			return
+		}
+		keep := j.Run()
+		p.done(j, keep)
+	}
+}
+
+// next waits for a job of the next ready destination, false means the pool is stopped
+func (p *Pool) next() (Job, bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for {
+		if p.stopped {
+			return Job{}, false
+		}
+		for i, d := range p.ready {
+			q := p.queues[d]
+			j := q[0]
+			if p.perHost > 0 && j.Host != "" && p.hosts[j.Host] >= p.perHost {
+				continue
+			}
+			p.ready = append(p.ready[:i], p.ready[i+1:]...)
+			if len(q) > 1 {
+				p.queues[d] = q[1:]
+			} else {
+				delete(p.queues, d)
+			}
+			p.jobs--
+			p.running[d] = true
+			if j.Host != "" {
+				p.hosts[j.Host]++
+			}
+			return j, true
+		}
+		p.cond.Wait()
+	}
+}
+
+// done releases the destination of the finished job
+func (p *Pool) done(j Job, keep bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	delete(p.running, j.Destination)
+	if j.Host != "" {
+		if p.hosts[j.Host]--; p.hosts[j.Host] <= 0 {
+			delete(p.hosts, j.Host)
+		}
+	}
+	if p.stopped {
+		return
+	}
+	if q, ok := p.queues[j.Destination]; ok {
+		if keep {
+			// to the end of the line
+			p.ready = append(p.ready, j.Destination)
+		} else {
+			p.jobs -= len(q)
+			delete(p.queues, j.Destination)
+		}
+	}
+	p.cond.Broadcast()
+}
@@ -0,0 +1,212 @@
+package delivery
+
+import (
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestOrder(t *testing.T) {
+	p := New(1, 0)
+	for _, id := range []string{"a1", "a2", "a3", "b1", "b2", "c1"} {
+		p.Submit(job(id, ""))
+	}
+
+	// destinations take turns, jobs of a destination are run in order of submission
+	for _, want := range []string{"a1", "b1", "c1", "a2", "b2", "a3"} {
+		j := next(t, p)
+		if got := jobID(j); got != want {
+			t.Fatalf("got %v, expected %v", got, want)
+		}
+		p.done(j, true)
+	}
+	if p.Jobs() != 0 || len(p.Destinations()) != 0 {
+		t.Fatalf("%v jobs of %v are left", p.Jobs(), p.Destinations())
+	}
+}
+
+func TestBusyDestination(t *testing.T) {
+	p := New(3, 0)
+	for _, id := range []string{"a1", "a2", "b1"} {
+		p.Submit(job(id, ""))
+	}
+
+	a1, b1 := next(t, p), next(t, p)
+	if jobID(a1) != "a1" || jobID(b1) != "b1" {
+		t.Fatalf("unexpected jobs %v, %v", jobID(a1), jobID(b1))
+	}
+
+	// next job of the destination waits for the running one
+	c := nextAsync(p)
+	expectNone(t, c)
+	p.done(b1, true)
+	expectNone(t, c)
+	p.done(a1, true)
+	if j := expect(t, c); jobID(j) != "a2" {
+		t.Fatalf("unexpected job %v", jobID(j))
+	}
+}
+
+func TestHostLimit(t *testing.T) {
+	p := New(3, 1)
+	p.Submit(job("a1", "h"))
+	p.Submit(job("b1", "h"))
+	p.Submit(job("c1", "g"))
+
+	// b1 is skipped: the host is busy
+	a1, c1 := next(t, p), next(t, p)
+	if jobID(a1) != "a1" || jobID(c1) != "c1" {
+		t.Fatalf("unexpected jobs %v, %v", jobID(a1), jobID(c1))
+	}
+	c := nextAsync(p)
+	expectNone(t, c)
+
+	// other host is released
+	p.done(c1, true)
+	expectNone(t, c)
+
+	// the host is released
+	p.done(a1, true)
+	if j := expect(t, c); jobID(j) != "b1" {
+		t.Fatalf("unexpected job %v", jobID(j))
+	}
+}
+
+func TestFailure(t *testing.T) {
+	p := New(1, 0)
+	for _, id := range []string{"a1", "a2", "a3", "b1"} {
+		p.Submit(job(id, ""))
+	}
+
+	// the rest of the destination's jobs are dropped on failure
+	a1 := next(t, p)
+	p.done(a1, false)
+	if p.Jobs() != 1 {
+		t.Fatalf("%v jobs are left", p.Jobs())
+	}
+	if d := p.Destinations(); len(d) != 1 || d[0] != "b" {
+		t.Fatalf("unexpected destinations %v", d)
+	}
+
+	// the destination is taken again once its jobs are submitted again
+	p.Submit(job("a1", ""))
+	p.Submit(job("a2", ""))
+	for _, want := range []string{"b1", "a1", "a2"} {
+		j := next(t, p)
+		if jobID(j) != want {
+			t.Fatalf("got %v, expected %v", jobID(j), want)
+		}
+		p.done(j, true)
+	}
+}
+
+func TestPool(t *testing.T) {
+	p := New(4, 2)
+	p.Start()
+	defer p.Stop()
+
+	// destination => host
+	hosts := map[string]string{"a": "x", "b": "x", "c": "x", "d": "y", "e": "y"}
+
+	var lock sync.Mutex
+	got := make(map[string][]int)
+	running := make(map[string]bool)
+	perHost := make(map[string]int)
+	wg := sync.WaitGroup{}
+	for i := 0; i < 20; i++ {
+		for _, d := range []string{"a", "b", "c", "d", "e"} {
+			d, i := d, i
+			wg.Add(1)
+			p.Submit(Job{
+				Destination: d,
+				Host:        hosts[d],
+				Run: func() bool {
+					defer wg.Done()
+					lock.Lock()
+					if running[d] {
+						t.Errorf("concurrent jobs of %v", d)
+					}
+					if perHost[hosts[d]]++; perHost[hosts[d]] > 2 {
+						t.Errorf("%v concurrent jobs of host %v", perHost[hosts[d]], hosts[d])
+					}
+					running[d] = true
+					got[d] = append(got[d], i)
+					lock.Unlock()
+
+					time.Sleep(time.Millisecond)
+
+					lock.Lock()
+					running[d] = false
+					perHost[hosts[d]]--
+					lock.Unlock()
+					return true
+				},
+			})
+		}
+	}
+	wg.Wait()
+
+	for d, list := range got {
+		if len(list) != 20 || !sort.IntsAreSorted(list) {
+			t.Fatalf("unexpected order of %v: %v", d, list)
+		}
+	}
+}
+
+// lastRun is ID of the job run last by jobID
+var lastRun string
+
+// job makes a job with destination of the first letter of ID
+func job(id, host string) Job {
+	return Job{
+		Destination: id[:1],
+		Host:        host,
+		Run: func() bool {
+			lastRun = id
+			return true
+		},
+	}
+}
+
+// jobID runs the job to get its ID
+func jobID(j Job) string {
+	j.Run()
+	return lastRun
+}
+
+// next gets the next job, it must be available
+func next(t *testing.T, p *Pool) Job {
+	return expect(t, nextAsync(p))
+}
+
+// nextAsync waits for the next job in background
+func nextAsync(p *Pool) <-chan Job {
+	c := make(chan Job, 1)
+	go func() {
+		if j, ok := p.next(); ok {
+			c <- j
+		}
+	}()
+	return c
+}
+
+func expect(t *testing.T, c <-chan Job) Job {
+	t.Helper()
+	select {
+	case j := <-c:
+		return j
+	case <-time.After(time.Second):
+		t.Fatal("no job is available")
+	}
+	return Job{}
+}
+
+func expectNone(t *testing.T, c <-chan Job) {
+	t.Helper()
+	select {
+	case j := <-c:
+		t.Fatalf("unexpected job %v", jobID(j))
+	case <-time.After(time.Millisecond * 50):
+	}
+}
@@ -2,15 +2,21 @@ package blockobserver
 
 import (
 	"math/big"
+	"time"
 
 	"github.com/sirupsen/logrus"
+	"github.com/void616/gm.mint.sender/internal/alert"
 	"github.com/void616/gm.mint.sender/internal/mint/blockparser"
 	"github.com/void616/gm.mint.sender/internal/mint/rpcpool"
 )
 
+// unreachableAlertAfter is a period of node unavailability to raise an alert
+const unreachableAlertAfter = time.Minute * 5
+
 // Observer listens for fresh blocks on an RPC connection
 type Observer struct {
 	logger  *logrus.Entry
+	alerter alert.Alerter
 	rpcpool *rpcpool.Pool
 	parser  *blockparser.Parser
 	from    *big.Int
@@ -18,16 +24,19 @@ type Observer struct {
 	blockID chan<- *big.Int
 }
 
-// New Observer instance
+// New Observer instance.
+// Observer tracks blocks continuity and emits an event into `reorg` on blockchain reorganization
 func New(
 	from *big.Int,
 	pool *rpcpool.Pool,
 	pubTX chan<- *blockparser.Transaction,
 	blockID chan<- *big.Int,
+	reorg chan<- *blockparser.Reorg,
+	alerter alert.Alerter,
 	logger *logrus.Entry,
 ) (*Observer, error) {
 
-	parser, err := blockparser.New(pool, pubTX, blockID)
+	parser, err := blockparser.New(pool, pubTX, blockID, reorg)
 	if err != nil {
 		return nil, err
 	}
@@ -35,6 +44,7 @@ func New(
 	o := &Observer{
 		rpcpool: pool,
 		logger:  logger,
+		alerter: alerter,
 		parser:  parser,
 		from:    from,
 	}
@@ -45,3 +55,10 @@ func New(
 func (o *Observer) AddMetrics(parser *blockparser.Metrics) {
 	o.parser.AddMetrics(parser)
 }
+
+// alertUnreachable raises an alert if the node is unreachable since `reachedAt` for too long
+func (o *Observer) alertUnreachable(reachedAt time.Time) {
+	if time.Since(reachedAt) >= unreachableAlertAfter {
+		o.alerter.LimitError(time.Minute*30, "Block observer can't reach Mint node for more than %v", unreachableAlertAfter)
+	}
+}
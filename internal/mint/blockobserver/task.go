@@ -5,12 +5,14 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/void616/gm.mint.sender/internal/mint/blockparser"
 	"github.com/void616/gotask"
 )
 
 // Task loop
 func (o *Observer) Task(token *gotask.Token) {
 	from := new(big.Int).Set(o.from)
+	reachedAt := time.Now()
 
 	for !token.Stopped() {
 
@@ -18,6 +20,7 @@ func (o *Observer) Task(token *gotask.Token) {
 		conn, connClose, err := o.rpcpool.ConnOnly()
 		if err != nil {
 			o.logger.WithError(err).Error("Failed to get a free connection")
+			o.alertUnreachable(reachedAt)
 			token.Sleep(time.Second * 10)
 			continue
 		}
@@ -25,10 +28,12 @@ func (o *Observer) Task(token *gotask.Token) {
 		// connection heartbeat
 		if err := conn.Heartbeat(time.Second * 5); err != nil {
 			o.logger.WithError(err).Error("Failed to check node connection")
+			o.alertUnreachable(reachedAt)
 			connClose()
 			token.Sleep(time.Second * 3)
 			continue
 		}
+		reachedAt = time.Now()
 
 		if token.Stopped() {
 			connClose()
@@ -60,12 +65,35 @@ func (o *Observer) Task(token *gotask.Token) {
 				if latest.Cmp(big.NewInt(-1)) > 0 {
 					o.logger.Warningf("Latest block from listener: %v", latest.String())
 
+					// blockchain reset
+					// rewind to death
+					for !token.Stopped() && from.Cmp(latest) > 0 {
+						fork, err := o.parser.Rewind(latest)
+						if err != nil {
+							o.logger.WithError(err).Error("Failed to rewind blocks")
+							o.alerter.LimitError(time.Minute*30, "Block observer fails to rewind blocks")
+							token.Sleep(time.Second * 10)
+							continue
+						}
+						if fork.Cmp(from) < 0 {
+							o.logger.Warnf("Blockchain reset, rolled back to block %v", fork.String())
+							from.Set(fork)
+						}
+						break
+					}
+
 					for !token.Stopped() && from.Cmp(latest) < 0 {
 						cur := new(big.Int).Set(from)
 						cur.Add(cur, big.NewInt(1))
 
 						if err := o.parser.Parse(cur); err != nil {
+							if e, ok := err.(*blockparser.ReorgError); ok {
+								o.logger.Warnf("Blockchain reorganization at block %v, rolled back to block %v", cur.String(), e.Fork.String())
+								from.Set(e.Fork)
+								continue
+							}
 							o.logger.WithError(err).WithField("block", cur.String()).Error("Failed to parse block")
+							o.alerter.LimitError(time.Minute*30, "Block observer fails to parse blocks")
 							token.Sleep(time.Second * 10)
 							continue
 						}
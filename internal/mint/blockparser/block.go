@@ -15,13 +15,21 @@ import (
 // Invoked when transaction-type-specific data should fill transaction model
 type ptxCbk func(t *Transaction)
 
-// parseBlockData parses block header and transactions from bytes
-func (p *Parser) parseBlockData(r io.Reader) error {
+// parseBlockData parses block header and transactions from bytes, passes transactions to `emit`, returns block digest.
+// Blocks continuity is checked if `continuity` is true
+func (p *Parser) parseBlockData(r io.Reader, continuity bool, emit func(*Transaction)) (mint.Digest, error) {
 	var blockModel *Block
-	return block.Parse(
+	var digest mint.Digest
+	err := block.Parse(
 		r,
 		// header parsed
 		func(h *block.Header) error {
+			// continuity
+			if continuity && !p.continuous(h.BlockID, h.PrevBlockDigest) {
+				return errDiscontinuity
+			}
+			digest = h.Digest
+
 			signers := make([]mint.PublicKey, 0)
 			for _, s := range h.Signers {
 				signers = append(signers, s.PublicKey)
@@ -48,7 +56,7 @@ func (p *Parser) parseBlockData(r io.Reader) error {
 			case transaction.RegisterNodeTx:
 				tx := transaction.RegisterNode{}
 				return mkTX(
-					&tx, t, d, h, p.pubTX,
+					&tx, t, d, h, emit,
 					func(m *Transaction) {
 						m.Data = tx.NodeAddress.Bytes()
 					},
@@ -57,7 +65,7 @@ func (p *Parser) parseBlockData(r io.Reader) error {
 			case transaction.UnregisterNodeTx:
 				tx := transaction.UnregisterNode{}
 				return mkTX(
-					&tx, t, d, h, p.pubTX,
+					&tx, t, d, h, emit,
 					func(m *Transaction) {
 						// nothing
 					},
@@ -66,7 +74,7 @@ func (p *Parser) parseBlockData(r io.Reader) error {
 			case transaction.TransferAssetTx:
 				tx := transaction.TransferAsset{}
 				return mkTX(
-					&tx, t, d, h, p.pubTX,
+					&tx, t, d, h, emit,
 					func(m *Transaction) {
 						to := tx.Address
 						m.To = &to
@@ -86,7 +94,7 @@ func (p *Parser) parseBlockData(r io.Reader) error {
 			case transaction.SetWalletTagTx:
 				tx := transaction.SetWalletTag{}
 				return mkTX(
-					&tx, t, d, h, p.pubTX,
+					&tx, t, d, h, emit,
 					func(m *Transaction) {
 						to := tx.Address
 						m.To = &to
@@ -97,7 +105,7 @@ func (p *Parser) parseBlockData(r io.Reader) error {
 			case transaction.UnsetWalletTagTx:
 				tx := transaction.UnsetWalletTag{}
 				return mkTX(
-					&tx, t, d, h, p.pubTX,
+					&tx, t, d, h, emit,
 					func(m *Transaction) {
 						to := tx.Address
 						m.To = &to
@@ -108,7 +116,7 @@ func (p *Parser) parseBlockData(r io.Reader) error {
 			case transaction.UserDataTx:
 				tx := transaction.UserData{}
 				return mkTX(
-					&tx, t, d, h, p.pubTX,
+					&tx, t, d, h, emit,
 					func(m *Transaction) {
 						m.Data = tx.Data
 						// stat
@@ -119,7 +127,7 @@ func (p *Parser) parseBlockData(r io.Reader) error {
 			case transaction.DistributionFeeTx:
 				tx := transaction.DistributionFee{}
 				return mkTX(
-					&tx, t, d, h, p.pubTX,
+					&tx, t, d, h, emit,
 					func(m *Transaction) {
 						to := tx.OwnerAddress
 						m.To = &to
@@ -135,11 +143,12 @@ func (p *Parser) parseBlockData(r io.Reader) error {
 			return fmt.Errorf("Transaction `%v` not implemented in parser", t)
 		},
 	)
+	return digest, err
 }
 
 // ---
 
-func mkTX(itx transaction.Transactioner, typ transaction.Code, d *serializer.Deserializer, h *block.Header, pubTX chan<- *Transaction, fillCbk ptxCbk) error {
+func mkTX(itx transaction.Transactioner, typ transaction.Code, d *serializer.Deserializer, h *block.Header, emit func(*Transaction), fillCbk ptxCbk) error {
 	ptx, err := itx.Parse(d.Source())
 	if err != nil {
 		return err
@@ -157,6 +166,6 @@ func mkTX(itx transaction.Transactioner, typ transaction.Code, d *serializer.Des
 		Data:       nil,
 	}
 	fillCbk(&m)
-	pubTX <- &m
+	emit(&m)
 	return nil
 }
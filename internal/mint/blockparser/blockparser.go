@@ -4,27 +4,43 @@ import (
 	"math/big"
 
 	"github.com/prometheus/client_golang/prometheus"
+	mint "github.com/void616/gm.mint"
 	"github.com/void616/gm.mint.sender/internal/mint/rpcpool"
 )
 
+// trackBlocks is a number of latest parsed blocks to keep digests of
+const trackBlocks = 64
+
 // Parser parses specific block data on demand and extracts transactions
 type Parser struct {
 	rpcpool *rpcpool.Pool
 	pubTX   chan<- *Transaction
 	blockID chan<- *big.Int
+	reorg   chan<- *Reorg
+	recent  []trackedBlock
 	metrics *Metrics
 }
 
-// New Parser instance
+// trackedBlock is a parsed block digest
+type trackedBlock struct {
+	id     *big.Int
+	digest mint.Digest
+}
+
+// New Parser instance.
+// Parser verifies blocks continuity (previous block digest) and emits reorganization events if `reorg` is not nil
 func New(
 	rpcpool *rpcpool.Pool,
 	pubTX chan<- *Transaction,
 	blockID chan<- *big.Int,
+	reorg chan<- *Reorg,
 ) (*Parser, error) {
 	ret := &Parser{
 		rpcpool: rpcpool,
 		pubTX:   pubTX,
 		blockID: blockID,
+		reorg:   reorg,
+		recent:  make([]trackedBlock, 0, trackBlocks),
 	}
 	return ret, nil
 }
@@ -1,6 +1,7 @@
 package blockparser
 
 import (
+	"fmt"
 	"math/big"
 	"time"
 
@@ -58,3 +59,34 @@ type Transaction struct {
 	// Data is an optional payload bytes
 	Data []byte
 }
+
+// FetchedBlock is a parsed but not yet published block
+type FetchedBlock struct {
+	// Block is ID
+	Block *big.Int
+	// Transactions of the block in order
+	Transactions []*Transaction
+}
+
+// Reorg is a blockchain reorganization event: blocks after Fork are replaced and are going to be parsed again.
+// Consumer should roll back the data above Fork and then call Done() to let the parser continue
+type Reorg struct {
+	// Fork is the latest block ID shared by the replaced and the actual chain
+	Fork *big.Int
+	done chan struct{}
+}
+
+// Done notifies the parser the data above the fork is rolled back
+func (r *Reorg) Done() {
+	close(r.done)
+}
+
+// ReorgError is returned by the parser on blockchain reorganization, parsing should be continued from Fork+1
+type ReorgError struct {
+	Fork *big.Int
+}
+
+// Error implementation
+func (e *ReorgError) Error() string {
+	return fmt.Sprintf("blockchain reorganization, fork at block %v", e.Fork.String())
+}
@@ -23,9 +23,25 @@ func (p *Parser) Parse(block *big.Int) error {
 	}
 
 	t = time.Now()
-	if err := p.parseBlockData(blockBytes); err != nil {
+	digest, err := p.parseBlockData(blockBytes, true, func(tx *Transaction) {
+		p.pubTX <- tx
+	})
+	if err == errDiscontinuity {
+		prev := new(big.Int).Sub(block, big.NewInt(1))
+		fork, err := p.rollback(prev)
+		if err != nil {
+			return err
+		}
+		// previous block is still in the chain, so the node is inconsistent
+		if fork.Cmp(prev) >= 0 {
+			return errDiscontinuity
+		}
+		return &ReorgError{Fork: fork}
+	}
+	if err != nil {
 		return err
 	}
+	p.track(block, digest)
 
 	// metrics
 	if p.metrics != nil {
@@ -36,3 +52,54 @@ func (p *Parser) Parse(block *big.Int) error {
 	p.blockID <- new(big.Int).Set(block)
 	return nil
 }
+
+// Rewind should be called when the node reports the latest block ID lower than the latest parsed one (blockchain reset).
+// It finds the latest parsed block which is still in the chain (or takes `latest` if there are no parsed blocks),
+// emits reorganization event if some blocks are replaced and returns the fork block ID
+func (p *Parser) Rewind(latest *big.Int) (*big.Int, error) {
+	return p.rollback(latest)
+}
+
+// Fetch requires and parses specified block by ID, but doesn't publish it.
+// Blocks continuity isn't checked, so it's safe to fetch blocks concurrently
+func (p *Parser) Fetch(block *big.Int) (*FetchedBlock, error) {
+	// metrics
+	t := time.Now()
+
+	// require block
+	blockBytes, err := p.queryBlockData(block)
+	if err != nil {
+		return nil, err
+	}
+	defer blockBytes.Close()
+
+	// metrics
+	if p.metrics != nil {
+		p.metrics.RequestDuration.Observe(time.Since(t).Seconds())
+	}
+
+	t = time.Now()
+	ret := &FetchedBlock{
+		Block:        new(big.Int).Set(block),
+		Transactions: make([]*Transaction, 0),
+	}
+	if _, err := p.parseBlockData(blockBytes, false, func(tx *Transaction) {
+		ret.Transactions = append(ret.Transactions, tx)
+	}); err != nil {
+		return nil, err
+	}
+
+	// metrics
+	if p.metrics != nil {
+		p.metrics.ParsingDuration.Observe(time.Since(t).Seconds())
+	}
+	return ret, nil
+}
+
+// Publish sends fetched block transactions and then the block ID as parsed
+func (p *Parser) Publish(b *FetchedBlock) {
+	for _, tx := range b.Transactions {
+		p.pubTX <- tx
+	}
+	p.blockID <- new(big.Int).Set(b.Block)
+}
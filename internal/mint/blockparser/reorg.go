@@ -0,0 +1,124 @@
+package blockparser
+
+import (
+	"errors"
+	"math/big"
+
+	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.rpc/request"
+	"github.com/void616/gm.mint.rpc/rpc"
+)
+
+// errDiscontinuity means block's previous digest doesn't match the tracked one
+var errDiscontinuity = errors.New("previous block digest mismatch")
+
+// continuous checks the block continues tracked chain
+func (p *Parser) continuous(id *big.Int, prevDigest mint.Digest) bool {
+	if p.reorg == nil || len(p.recent) == 0 {
+		return true
+	}
+	prev := p.recent[len(p.recent)-1]
+	if new(big.Int).Sub(id, prev.id).Cmp(big.NewInt(1)) != 0 {
+		// not a next block, nothing to compare with
+		return true
+	}
+	return prev.digest == prevDigest
+}
+
+// track remembers parsed block digest
+func (p *Parser) track(id *big.Int, digest mint.Digest) {
+	if p.reorg == nil {
+		return
+	}
+	// keep contiguous sequence
+	if len(p.recent) > 0 && new(big.Int).Sub(id, p.recent[len(p.recent)-1].id).Cmp(big.NewInt(1)) != 0 {
+		p.recent = p.recent[:0]
+	}
+	if len(p.recent) == trackBlocks {
+		copy(p.recent, p.recent[1:])
+		p.recent = p.recent[:len(p.recent)-1]
+	}
+	p.recent = append(p.recent, trackedBlock{
+		id:     new(big.Int).Set(id),
+		digest: digest,
+	})
+}
+
+// rollback finds the latest tracked block which is still in the chain, forgets blocks after it and emits reorganization event.
+// `fallback` is used as a fork point if there are no tracked blocks
+func (p *Parser) rollback(fallback *big.Int) (*big.Int, error) {
+	if len(p.recent) == 0 {
+		return p.emitReorg(fallback), nil
+	}
+
+	fork := new(big.Int).Set(p.recent[len(p.recent)-1].id)
+	for i := len(p.recent) - 1; i >= 0; i-- {
+		b := p.recent[i]
+		digest, ok, err := p.queryBlockDigest(b.id)
+		if err != nil {
+			return nil, err
+		}
+		if ok && digest == b.digest {
+			fork.Set(b.id)
+			break
+		}
+		// replaced, so the fork is before this block
+		fork.Sub(b.id, big.NewInt(1))
+	}
+	if fork.Sign() < 0 {
+		fork.SetInt64(0)
+	}
+
+	// nothing is replaced
+	if fork.Cmp(p.recent[len(p.recent)-1].id) == 0 {
+		return fork, nil
+	}
+
+	// forget
+	for len(p.recent) > 0 && p.recent[len(p.recent)-1].id.Cmp(fork) > 0 {
+		p.recent = p.recent[:len(p.recent)-1]
+	}
+	return p.emitReorg(fork), nil
+}
+
+// emitReorg notifies about reorganization and waits for the rollback
+func (p *Parser) emitReorg(fork *big.Int) *big.Int {
+	if p.reorg != nil {
+		r := &Reorg{
+			Fork: new(big.Int).Set(fork),
+			done: make(chan struct{}),
+		}
+		p.reorg <- r
+		<-r.done
+	}
+	return new(big.Int).Set(fork)
+}
+
+// queryBlockDigest queries block header digest via RPC connection, returns false if the block doesn't exist
+func (p *Parser) queryBlockDigest(id *big.Int) (mint.Digest, bool, error) {
+	ctx, conn, cls, err := p.rpcpool.Conn()
+	if err != nil {
+		return mint.Digest{}, false, err
+	}
+	defer cls()
+
+	blockData, rerr, err := request.GetBlockByID(ctx, conn, id)
+	if rerr != nil {
+		if code, _, ok := rerr.GetReason(); ok && code == rpc.EBlockNotFound {
+			return mint.Digest{}, false, nil
+		}
+		if rerr.Error.Code == rpc.EBlockNotFound {
+			return mint.Digest{}, false, nil
+		}
+		return mint.Digest{}, false, rerr.Err()
+	}
+	if err != nil {
+		return mint.Digest{}, false, err
+	}
+
+	h, err := blockData.Header()
+	if err != nil {
+		return mint.Digest{}, false, err
+	}
+	return h.Digest, true, nil
+}
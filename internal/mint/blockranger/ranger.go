@@ -4,28 +4,36 @@ import (
 	"errors"
 	"math/big"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
+	"github.com/void616/gm.mint.sender/internal/alert"
 	"github.com/void616/gm.mint.sender/internal/mint/blockparser"
 	"github.com/void616/gm.mint.sender/internal/mint/rpcpool"
 )
 
 // Ranger parses range of blocks sending IDs to the parsers channel
 type Ranger struct {
-	logger *logrus.Entry
-	from   *big.Int
-	to     *big.Int
-	parser *blockparser.Parser
-	pubTX  chan<- *blockparser.Transaction
+	logger  *logrus.Entry
+	alerter alert.Alerter
+	from    *big.Int
+	to      *big.Int
+	workers uint16
+	parser  *blockparser.Parser
+	pubTX   chan<- *blockparser.Transaction
+	metrics *Metrics
 }
 
 // New Ranger instance.
 // Parses blocks from `fromBlockID` to `toBlockID` (inclusive).
+// Blocks are fetched concurrently by `workers` (at least one), but transactions are published in blocks order
 func New(
 	fromBlockID,
 	toBlockID *big.Int,
+	workers uint16,
 	pool *rpcpool.Pool,
 	pubTX chan<- *blockparser.Transaction,
 	blockID chan<- *big.Int,
+	alerter alert.Alerter,
 	logger *logrus.Entry,
 ) (*Ranger, error) {
 
@@ -42,21 +50,36 @@ func New(
 		return nil, errors.New("invalid range")
 	}
 
-	parser, err := blockparser.New(pool, pubTX, blockID)
+	// parsed range is considered as settled, so there is no reorganizations tracking
+	parser, err := blockparser.New(pool, pubTX, blockID, nil)
 	if err != nil {
 		return nil, err
 	}
 
+	if workers == 0 {
+		workers = 1
+	}
+
 	r := &Ranger{
-		logger: logger,
-		from:   from,
-		to:     to,
-		parser: parser,
+		logger:  logger,
+		alerter: alerter,
+		from:    from,
+		to:      to,
+		workers: workers,
+		parser:  parser,
 	}
 	return r, nil
 }
 
+// Metrics data
+type Metrics struct {
+	Remaining    prometheus.Gauge
+	ETA          prometheus.Gauge
+	WorkerBlocks *prometheus.CounterVec
+}
+
 // AddMetrics adds metrics counters and should be called before service launch
-func (r *Ranger) AddMetrics(parser *blockparser.Metrics) {
+func (r *Ranger) AddMetrics(parser *blockparser.Metrics, ranger *Metrics) {
 	r.parser.AddMetrics(parser)
+	r.metrics = ranger
 }
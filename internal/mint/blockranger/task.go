@@ -1,33 +1,144 @@
 package blockranger
 
 import (
+	"fmt"
 	"math/big"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/void616/gm.mint.sender/internal/mint/blockparser"
 	"github.com/void616/gotask"
 )
 
+// fetchAheadPerWorker limits a number of fetched but not yet published blocks
+const fetchAheadPerWorker = 4
+
+// progressInterval is a period of progress logging
+const progressInterval = time.Second * 30
+
+// fetched block from a worker
+type fetched struct {
+	worker int
+	block  *blockparser.FetchedBlock
+}
+
 // Task loop
 func (r *Ranger) Task(token *gotask.Token) {
-	r.logger.Infof("Processing range from %v to %v", r.from.String(), r.to.String())
+	r.logger.Infof("Processing range from %v to %v (%v workers)", r.from.String(), r.to.String(), r.workers)
+
+	var (
+		one     = big.NewInt(1)
+		window  = int(r.workers) * fetchAheadPerWorker
+		jobs    = make(chan *big.Int, window)
+		results = make(chan fetched, window)
+		wg      sync.WaitGroup
+	)
 
-	cur := new(big.Int).Set(r.from)
-	one := big.NewInt(1)
+	// workers
+	for i := 0; i < int(r.workers); i++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for id := range jobs {
+				for {
+					b, err := r.parser.Fetch(id)
+					if err == nil {
+						results <- fetched{worker: worker, block: b}
+						break
+					}
+					r.logger.WithError(err).WithField("block", id.String()).WithField("worker", worker).Error("Failed to parse block")
+					r.alerter.LimitError(time.Minute*30, "Block ranger fails to parse blocks")
+					token.Sleep(time.Second * 10)
+					if token.Stopped() {
+						return
+					}
+				}
+			}
+		}(i)
+	}
 
-	for !token.Stopped() {
+	var (
+		total        = new(big.Int).Add(new(big.Int).Sub(r.to, r.from), one).Uint64()
+		issue        = new(big.Int).Set(r.from)
+		next         = new(big.Int).Set(r.from)
+		pending      = make(map[string]*blockparser.FetchedBlock)
+		inflight     = 0
+		completed    = uint64(0)
+		workerBlocks = make([]uint64, r.workers)
+		started      = time.Now()
+		logged       = time.Now()
+	)
+
+	for !token.Stopped() && next.Cmp(r.to) <= 0 {
+
+		// enqueue blocks to fetch
+		for inflight < window && issue.Cmp(r.to) <= 0 {
+			jobs <- new(big.Int).Set(issue)
+			issue.Add(issue, one)
+			inflight++
+		}
+
+		select {
+		case f := <-results:
+			pending[f.block.Block.String()] = f.block
+			workerBlocks[f.worker]++
+			if r.metrics != nil {
+				r.metrics.WorkerBlocks.WithLabelValues(strconv.Itoa(f.worker)).Inc()
+			}
+		case <-time.After(time.Second):
+		}
 
-		if err := r.parser.Parse(cur); err != nil {
-			r.logger.WithError(err).WithField("block", cur.String()).Error("Failed to parse block")
-			token.Sleep(time.Second * 10)
-			continue
+		// publish in blocks order
+		for {
+			b, ok := pending[next.String()]
+			if !ok {
+				break
+			}
+			delete(pending, next.String())
+			r.parser.Publish(b)
+			r.logger.WithField("block", next.String()).Debugf("Block completed")
+			next.Add(next, one)
+			inflight--
+			completed++
 		}
-		r.logger.WithField("block", cur.String()).Debugf("Block completed")
 
-		cur.Add(cur, one)
-		if cur.Cmp(r.to) > 0 {
-			r.logger.Infof("Range from %v to %v is completed", r.from.String(), r.to.String())
-			token.Stop()
-			continue
+		if time.Since(logged) >= progressInterval {
+			r.progress(completed, total, started, workerBlocks)
+			logged = time.Now()
 		}
 	}
+
+	close(jobs)
+	wg.Wait()
+
+	if next.Cmp(r.to) > 0 {
+		r.progress(completed, total, started, workerBlocks)
+		r.logger.Infof("Range from %v to %v is completed", r.from.String(), r.to.String())
+		token.Stop()
+	}
+}
+
+// progress logs range parsing progress and ETA
+func (r *Ranger) progress(completed, total uint64, started time.Time, workerBlocks []uint64) {
+	remaining := total - completed
+	eta := time.Duration(0)
+	if completed > 0 {
+		eta = time.Duration(float64(time.Since(started)) / float64(completed) * float64(remaining))
+	}
+
+	workers := make([]string, len(workerBlocks))
+	for i, n := range workerBlocks {
+		workers[i] = fmt.Sprintf("%v:%v", i, n)
+	}
+
+	r.logger.
+		WithField("workers", strings.Join(workers, " ")).
+		Infof("Parsed %v of %v blocks (%.1f%%), ETA %v", completed, total, float64(completed)*100/float64(total), eta.Round(time.Second))
+
+	if r.metrics != nil {
+		r.metrics.Remaining.Set(float64(remaining))
+		r.metrics.ETA.Set(eta.Seconds())
+	}
 }
@@ -0,0 +1,346 @@
+package fakenode
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.rpc/rpc"
+	"github.com/void616/gm.mint/amount"
+	"github.com/void616/gm.mint/fee"
+	"github.com/void616/gm.mint/serializer"
+	"github.com/void616/gm.mint/transaction"
+	"golang.org/x/crypto/sha3"
+)
+
+// epochStart is Mint timestamps origin
+var epochStart = time.Date(1400, 01, 01, 00, 00, 00, 000000, time.UTC)
+
+// rejection describes a reason of transaction rejection in terms of node RPC
+type rejection struct {
+	code rpc.ErrorCode
+	desc string
+}
+
+func reject(code rpc.ErrorCode, format string, args ...interface{}) *rejection {
+	return &rejection{code: code, desc: fmt.Sprintf(format, args...)}
+}
+
+// addTransaction validates a signed transaction and puts it into the pool
+func (n *Node) addTransaction(name, data string) *rejection {
+	code, err := transaction.ParseCode(name)
+	if err != nil {
+		return reject(rpc.EMalformedRequest, "%v", err)
+	}
+	b, err := hex.DecodeString(data)
+	if err != nil {
+		return reject(rpc.EMalformedRequest, "failed to decode transaction data: %v", err)
+	}
+
+	tx, err := transaction.CodeToTransaction(code)
+	if err != nil {
+		return reject(rpc.EBadTransaction, "%v", err)
+	}
+	parsed, err := tx.Parse(bytes.NewReader(b))
+	if err != nil {
+		return reject(rpc.EBadTransaction, "failed to parse transaction: %v", err)
+	}
+
+	// payload + "signed" byte + signature
+	payloadLen := len(b) - 1 - mint.SignatureSize
+	if payloadLen <= 0 || b[payloadLen] == 0 {
+		return reject(rpc.ETransactionNotSigned, "transaction is not signed")
+	}
+	if err := transaction.Verify(parsed.From, b[:payloadLen], parsed.Signature); err != nil {
+		return reject(rpc.EBadTransactionSignature, "%v", err)
+	}
+
+	n.chainLock.Lock()
+	defer n.chainLock.Unlock()
+
+	w := n.wallet(parsed.From, false)
+	if w == nil {
+		return reject(rpc.ETransactionWalletNotFound, "wallet %v not found", parsed.From.String())
+	}
+	if parsed.Nonce <= w.nonce {
+		return reject(rpc.EBadTransactionID, "transaction ID %v is behind wallet's ID %v", parsed.Nonce, w.nonce)
+	}
+	if parsed.Nonce > w.nonce+maxNonceDelta {
+		return reject(rpc.EBadTransactionDeltaID, "transaction ID %v is too far from wallet's ID %v", parsed.Nonce, w.nonce)
+	}
+	for _, ptx := range n.pool {
+		if ptx.parsed.From == parsed.From && ptx.parsed.Nonce == parsed.Nonce {
+			return reject(rpc.ETransactionIDExistsInPendingPool, "transaction ID %v exists in pending pool", parsed.Nonce)
+		}
+	}
+	// check against current state, the state could change until the transaction is mined
+	if rej := n.apply(tx, parsed, true); rej != nil {
+		return rej
+	}
+
+	n.pool = append(n.pool, &poolTransaction{
+		code:   code,
+		data:   b,
+		tx:     tx,
+		parsed: parsed,
+	})
+	return nil
+}
+
+// apply applies a transaction to the state (or just checks it). Caller holds chainLock
+func (n *Node) apply(tx transaction.Transactioner, parsed *transaction.ParsedTransaction, check bool) *rejection {
+	from := n.wallet(parsed.From, false)
+	if from == nil {
+		return reject(rpc.ETransactionWalletNotFound, "wallet %v not found", parsed.From.String())
+	}
+
+	switch t := tx.(type) {
+	case *transaction.TransferAsset:
+		if t.Amount.Value.Sign() <= 0 {
+			return reject(rpc.EBadTransaction, "invalid amount")
+		}
+		if t.Address == parsed.From {
+			return reject(rpc.EBadTransaction, "sending to itself")
+		}
+		to := n.wallet(t.Address, false)
+
+		emitter := from.has(mint.WalletTagEmission)
+		feeless := emitter || from.has(mint.WalletTagNoFee) || from.has(mint.WalletTagOwner)
+
+		var balance *big.Int
+		var txfee *amount.Amount
+		switch t.Token {
+		case mint.TokenGOLD:
+			// GOLD is sent/received by approved wallets only
+			if !emitter {
+				if !from.has(mint.WalletTagApproved) && !from.has(mint.WalletTagDeposital) {
+					return reject(rpc.EBadTransaction, "sender is not approved")
+				}
+				if to == nil || (!to.has(mint.WalletTagApproved) && !to.has(mint.WalletTagDeposital) && !to.has(mint.WalletTagEmission)) {
+					return reject(rpc.EBadTransaction, "recipient is not approved")
+				}
+			}
+			balance = from.gold
+			txfee = fee.GoldFee(t.Amount, amount.FromBig(from.mnt))
+		case mint.TokenMNT:
+			balance = from.mnt
+			txfee = fee.MntFee(t.Amount)
+		default:
+			return reject(rpc.EBadTransaction, "unsupported token")
+		}
+		if feeless {
+			txfee = amount.New()
+		}
+
+		// emission wallet emits tokens
+		total := new(big.Int).Add(t.Amount.Value, txfee.Value)
+		if !emitter && balance.Cmp(total) < 0 {
+			return reject(rpc.EBadTransaction, "insufficient balance")
+		}
+		if check {
+			return nil
+		}
+
+		if !emitter {
+			balance.Sub(balance, total)
+		}
+		to = n.wallet(t.Address, true)
+		switch t.Token {
+		case mint.TokenGOLD:
+			to.gold.Add(to.gold, t.Amount.Value)
+		case mint.TokenMNT:
+			to.mnt.Add(to.mnt, t.Amount.Value)
+		}
+		// fee is collected by owner or burnt
+		if owner := n.owner(); owner != nil {
+			switch t.Token {
+			case mint.TokenGOLD:
+				owner.gold.Add(owner.gold, txfee.Value)
+			case mint.TokenMNT:
+				owner.mnt.Add(owner.mnt, txfee.Value)
+			}
+		}
+
+	case *transaction.SetWalletTag:
+		if !from.canTag(t.Tag) {
+			return reject(rpc.EBadTransaction, "sender can't set tag %v", t.Tag.String())
+		}
+		if check {
+			return nil
+		}
+		n.wallet(t.Address, true).tags[t.Tag] = struct{}{}
+
+	case *transaction.UnsetWalletTag:
+		if !from.canTag(t.Tag) {
+			return reject(rpc.EBadTransaction, "sender can't unset tag %v", t.Tag.String())
+		}
+		if check {
+			return nil
+		}
+		if to := n.wallet(t.Address, false); to != nil {
+			delete(to.tags, t.Tag)
+		}
+
+	default:
+		return reject(rpc.EBadTransaction, "transaction %v is not supported", tx.Code().String())
+	}
+
+	from.nonce = parsed.Nonce
+	return nil
+}
+
+// owner returns fee collector wallet. Caller holds chainLock
+func (n *Node) owner() *wallet {
+	for _, w := range n.wallets {
+		if w.has(mint.WalletTagOwner) {
+			return w
+		}
+	}
+	return nil
+}
+
+// has checks wallet has the tag
+func (w *wallet) has(t mint.WalletTag) bool {
+	_, ok := w.tags[t]
+	return ok
+}
+
+// canTag checks wallet can set/unset the tag
+func (w *wallet) canTag(t mint.WalletTag) bool {
+	switch {
+	case w.has(mint.WalletTagSupervisor):
+		return true
+	case t == mint.WalletTagApproved:
+		return w.has(mint.WalletTagAuthority)
+	case t == mint.WalletTagDeposital:
+		return w.has(mint.WalletTagExchange)
+	}
+	return false
+}
+
+// mine mines pending transactions into a new block
+func (n *Node) mine() (*minedBlock, error) {
+	n.chainLock.Lock()
+	defer n.chainLock.Unlock()
+
+	// transactions are applied in nonce order, a transaction waits in the pool for preceding ones
+	sort.SliceStable(n.pool, func(i, j int) bool {
+		return n.pool[i].parsed.Nonce < n.pool[j].parsed.Nonce
+	})
+
+	txs := make([]*poolTransaction, 0)
+	for progress := true; progress && len(txs) < maxBlockTransactions; {
+		progress = false
+		rest := make([]*poolTransaction, 0, len(n.pool))
+		for _, ptx := range n.pool {
+			w := n.wallet(ptx.parsed.From, false)
+			switch {
+			// stale
+			case w == nil || ptx.parsed.Nonce <= w.nonce:
+				n.logger.Debugf("Dropping stale transaction %v", ptx.parsed.Digest.String())
+			// next one
+			case ptx.parsed.Nonce == w.nonce+1 && len(txs) < maxBlockTransactions:
+				if rej := n.apply(ptx.tx, ptx.parsed, false); rej != nil {
+					n.logger.Debugf("Dropping transaction %v: %v", ptx.parsed.Digest.String(), rej.desc)
+					continue
+				}
+				txs = append(txs, ptx)
+				progress = true
+			default:
+				rest = append(rest, ptx)
+			}
+		}
+		n.pool = rest
+	}
+
+	b, err := n.serializeBlock(txs)
+	if err != nil {
+		return nil, err
+	}
+	b.txs = len(txs)
+	b.state = copyWallets(n.wallets)
+	n.blocks = append(n.blocks, b)
+	n.txCount += uint64(len(txs))
+	return b, nil
+}
+
+// serializeBlock makes a new signed block. Caller holds chainLock
+func (n *Node) serializeBlock(txs []*poolTransaction) (*minedBlock, error) {
+	var prevDigest mint.Digest
+	if len(n.blocks) > 0 {
+		prevDigest = n.blocks[len(n.blocks)-1].digest
+	}
+	id := big.NewInt(int64(len(n.blocks)))
+
+	// merkle root (simplified: digest of transactions digests)
+	var merkleRoot mint.Digest
+	{
+		hasher := sha3.New256()
+		for _, ptx := range txs {
+			hasher.Write(ptx.parsed.Digest[:])
+		}
+		copy(merkleRoot[:], hasher.Sum(nil))
+	}
+
+	now := time.Now().UTC()
+	timestamp := uint64(now.Unix()-epochStart.Unix())*1000000 + uint64(now.Nanosecond()/1000)
+
+	// header
+	hs := serializer.NewSerializer()
+	hs.PutUint16(1)                // version
+	hs.PutBytes(prevDigest[:])     // previous block digest
+	hs.PutUint16(0)                // consensus round
+	hs.PutBytes(merkleRoot[:])     // merkle root
+	hs.PutUint64(timestamp)        // time
+	hs.PutUint16(uint16(len(txs))) // transactions
+	hs.PutBytes(uint256(id))       // block
+	header, err := hs.Data()
+	if err != nil {
+		return nil, err
+	}
+
+	// header digest includes timestamp length (see block.Parse)
+	var digest mint.Digest
+	{
+		hasher := sha3.New256()
+		hasher.Write(header[:2+mint.DigestSize+2+mint.DigestSize])
+		hasher.Write([]byte{8, 0, 0, 0})
+		hasher.Write(header[2+mint.DigestSize+2+mint.DigestSize:])
+		copy(digest[:], hasher.Sum(nil))
+	}
+
+	s := serializer.NewSerializer()
+	s.PutBytes(header)
+	s.PutUint16(1) // signers
+	s.PutPublicKey(n.signer.PublicKey())
+	sig := n.signer.Sign(digest[:])
+	s.PutBytes(sig[:])
+	for _, ptx := range txs {
+		s.PutUint16(uint16(ptx.code))
+		s.PutBytes(ptx.data)
+	}
+	data, err := s.Data()
+	if err != nil {
+		return nil, err
+	}
+
+	return &minedBlock{
+		id:     id,
+		digest: digest,
+		data:   data,
+	}, nil
+}
+
+// uint256 makes 32 bytes little-endian representation of unsigned integer
+func uint256(v *big.Int) []byte {
+	be := v.Bytes()
+	ret := make([]byte, 32)
+	for i := 0; i < len(be) && i < len(ret); i++ {
+		ret[i] = be[len(be)-1-i]
+	}
+	return ret
+}
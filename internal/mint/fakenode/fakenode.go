@@ -0,0 +1,260 @@
+package fakenode
+
+import (
+	"fmt"
+	"math/big"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.rpc/request"
+	"github.com/void616/gm.mint/amount"
+	"github.com/void616/gm.mint/signer"
+	"github.com/void616/gm.mint/transaction"
+)
+
+const (
+	// maxBlockTransactions is max number of transactions mined into a single block
+	maxBlockTransactions = 1000
+	// maxNonceDelta is max distance between latest approved wallet nonce and a new transaction nonce
+	maxNonceDelta = 2 * maxBlockTransactions
+)
+
+// Node is an in-memory Mint node, serving node RPC on a local socket.
+// It holds wallets (balances, tags, nonces), accepts signed transactions into a pool and mines them into blocks
+type Node struct {
+	logger   *logrus.Entry
+	listener net.Listener
+	signer   *signer.Signer
+	interval time.Duration
+
+	chainLock sync.Mutex
+	wallets   map[mint.PublicKey]*wallet
+	blocks    []*minedBlock
+	pool      []*poolTransaction
+	txCount   uint64
+
+	clientsLock sync.Mutex
+	clients     map[*client]struct{}
+}
+
+// wallet state
+type wallet struct {
+	gold  *big.Int
+	mnt   *big.Int
+	tags  map[mint.WalletTag]struct{}
+	nonce uint64
+}
+
+// minedBlock is a serialized block
+type minedBlock struct {
+	id     *big.Int
+	digest mint.Digest
+	data   []byte
+	txs    int
+	// wallets state after the block is mined
+	state map[mint.PublicKey]*wallet
+}
+
+// poolTransaction is a pending transaction
+type poolTransaction struct {
+	code   transaction.Code
+	data   []byte
+	tx     transaction.Transactioner
+	parsed *transaction.ParsedTransaction
+}
+
+// New Node instance listening on addr (use 127.0.0.1:0 to pick a free port).
+// Node mines pending transactions every interval, or on Mine() call only if interval is zero
+func New(addr string, interval time.Duration, logger *logrus.Entry) (*Node, error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := signer.New()
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	n := &Node{
+		logger:   logger,
+		listener: listener,
+		signer:   sig,
+		interval: interval,
+		wallets:  make(map[mint.PublicKey]*wallet),
+		blocks:   make([]*minedBlock, 0),
+		pool:     make([]*poolTransaction, 0),
+		clients:  make(map[*client]struct{}),
+	}
+
+	// genesis
+	if _, err := n.mine(); err != nil {
+		listener.Close()
+		return nil, err
+	}
+	return n, nil
+}
+
+// Addr returns node's RPC address
+func (n *Node) Addr() string {
+	return n.listener.Addr().String()
+}
+
+// SetBalance sets wallet balance of specified token (creates the wallet if needed)
+func (n *Node) SetBalance(pub mint.PublicKey, t mint.Token, a *amount.Amount) {
+	n.chainLock.Lock()
+	defer n.chainLock.Unlock()
+
+	w := n.wallet(pub, true)
+	switch t {
+	case mint.TokenGOLD:
+		w.gold.Set(a.Value)
+	case mint.TokenMNT:
+		w.mnt.Set(a.Value)
+	}
+	n.snapshot()
+}
+
+// SetTag sets wallet tag (creates the wallet if needed)
+func (n *Node) SetTag(pub mint.PublicKey, tag mint.WalletTag) {
+	n.chainLock.Lock()
+	defer n.chainLock.Unlock()
+
+	n.wallet(pub, true).tags[tag] = struct{}{}
+	n.snapshot()
+}
+
+// UnsetTag removes wallet tag
+func (n *Node) UnsetTag(pub mint.PublicKey, tag mint.WalletTag) {
+	n.chainLock.Lock()
+	defer n.chainLock.Unlock()
+
+	if w := n.wallet(pub, false); w != nil {
+		delete(w.tags, tag)
+		n.snapshot()
+	}
+}
+
+// WalletState returns current wallet state as node replies to get_wallet_state
+func (n *Node) WalletState(pub mint.PublicKey) request.WalletState {
+	n.chainLock.Lock()
+	defer n.chainLock.Unlock()
+
+	return n.walletState(pub)
+}
+
+// LatestBlock returns latest mined block ID
+func (n *Node) LatestBlock() *big.Int {
+	n.chainLock.Lock()
+	defer n.chainLock.Unlock()
+
+	return new(big.Int).Set(n.blocks[len(n.blocks)-1].id)
+}
+
+// PendingTransactions returns a number of transactions in the pool
+func (n *Node) PendingTransactions() int {
+	n.chainLock.Lock()
+	defer n.chainLock.Unlock()
+
+	return len(n.pool)
+}
+
+// Mine mines pending transactions into a new block (empty block if there are no transactions) and notifies clients
+func (n *Node) Mine() (*big.Int, error) {
+	b, err := n.mine()
+	if err != nil {
+		return nil, err
+	}
+	n.broadcastBlocksSynchronized(b)
+	return new(big.Int).Set(b.id), nil
+}
+
+// Reorganize replaces blocks after the fork block: wallets state is restored as of the fork block (including the state set up
+// while it was the latest block), transactions of the replaced blocks are dropped. Clients are notified once new blocks are mined
+func (n *Node) Reorganize(fork *big.Int) error {
+	n.chainLock.Lock()
+	defer n.chainLock.Unlock()
+
+	if fork.Sign() < 0 || !fork.IsInt64() || fork.Int64() >= int64(len(n.blocks)) {
+		return fmt.Errorf("block %v not found", fork.String())
+	}
+	for _, b := range n.blocks[fork.Int64()+1:] {
+		n.txCount -= uint64(b.txs)
+	}
+	n.blocks = n.blocks[:fork.Int64()+1]
+	n.wallets = copyWallets(n.blocks[len(n.blocks)-1].state)
+	return nil
+}
+
+// snapshot saves wallets state into the latest block, so the state set up out of transactions survives reorganization.
+// Caller holds chainLock
+func (n *Node) snapshot() {
+	n.blocks[len(n.blocks)-1].state = copyWallets(n.wallets)
+}
+
+// wallet gets wallet by public key, creating it if needed. Caller holds chainLock
+func (n *Node) wallet(pub mint.PublicKey, create bool) *wallet {
+	w, ok := n.wallets[pub]
+	if !ok && create {
+		w = &wallet{
+			gold: new(big.Int),
+			mnt:  new(big.Int),
+			tags: make(map[mint.WalletTag]struct{}),
+		}
+		n.wallets[pub] = w
+	}
+	return w
+}
+
+// walletState makes wallet state model. Caller holds chainLock
+func (n *Node) walletState(pub mint.PublicKey) request.WalletState {
+	ret := request.WalletState{
+		Balance: request.Balance{
+			Gold: amount.New(),
+			Mnt:  amount.New(),
+		},
+		Tags: make([]string, 0),
+	}
+
+	w := n.wallet(pub, false)
+	if w == nil {
+		return ret
+	}
+
+	ret.Exist = true
+	ret.Balance.Gold = amount.FromBig(w.gold)
+	ret.Balance.Mnt = amount.FromBig(w.mnt)
+	for t := range w.tags {
+		ret.Tags = append(ret.Tags, t.String())
+	}
+	ret.LastTransactionID = w.nonce
+	ret.LastPoolTransactionID = w.nonce
+	for _, ptx := range n.pool {
+		if ptx.parsed.From == pub && ptx.parsed.Nonce > ret.LastPoolTransactionID {
+			ret.LastPoolTransactionID = ptx.parsed.Nonce
+		}
+	}
+	return ret
+}
+
+// copyWallets makes a deep copy of wallets state
+func copyWallets(src map[mint.PublicKey]*wallet) map[mint.PublicKey]*wallet {
+	ret := make(map[mint.PublicKey]*wallet, len(src))
+	for pub, w := range src {
+		c := &wallet{
+			gold:  new(big.Int).Set(w.gold),
+			mnt:   new(big.Int).Set(w.mnt),
+			tags:  make(map[mint.WalletTag]struct{}, len(w.tags)),
+			nonce: w.nonce,
+		}
+		for t := range w.tags {
+			c.tags[t] = struct{}{}
+		}
+		ret[pub] = c
+	}
+	return ret
+}
@@ -0,0 +1,59 @@
+package fakenode_test
+
+import (
+	"math/big"
+	"testing"
+
+	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/mint/fakenode"
+	"github.com/void616/gm.mint/amount"
+	"github.com/void616/gm.mint/signer"
+)
+
+// Wallets set up out of transactions are kept on reorganization, blocks after the fork are dropped
+func TestReorganize(t *testing.T) {
+	node, err := fakenode.New("127.0.0.1:0", 0, testLogger().WithField("task", "node"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	run(t, "node", node.Task)
+
+	sig, err := signer.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	node.SetBalance(sig.PublicKey(), mint.TokenGOLD, amount.MustFromString("10"))
+	node.SetTag(sig.PublicKey(), mint.WalletTagApproved)
+
+	for i := 0; i < 3; i++ {
+		if _, err := node.Mine(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	node.SetBalance(sig.PublicKey(), mint.TokenGOLD, amount.MustFromString("20"))
+
+	// back to block 1
+	if err := node.Reorganize(big.NewInt(1)); err != nil {
+		t.Fatal(err)
+	}
+	if b := node.LatestBlock(); b.Int64() != 1 {
+		t.Fatalf("latest block is %v", b)
+	}
+	state := node.WalletState(sig.PublicKey())
+	if state.Balance.Gold.String() != amount.MustFromString("10").String() || len(state.Tags) != 1 {
+		t.Fatalf("wallet state is %v %v", state.Balance.Gold.String(), state.Tags)
+	}
+
+	// back to genesis
+	if err := node.Reorganize(big.NewInt(0)); err != nil {
+		t.Fatal(err)
+	}
+	state = node.WalletState(sig.PublicKey())
+	if !state.Exist || state.Balance.Gold.String() != amount.MustFromString("10").String() || len(state.Tags) != 1 {
+		t.Fatalf("setup wallet state is lost: %v %v", state.Balance.Gold.String(), state.Tags)
+	}
+
+	if err := node.Reorganize(big.NewInt(1)); err == nil {
+		t.Fatal("reorganized to unknown block")
+	}
+}
@@ -0,0 +1,155 @@
+package fakenode
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+
+	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.rpc/request"
+	"github.com/void616/gm.mint.rpc/rpc"
+	"github.com/void616/gm.mint/amount"
+	"github.com/void616/gm.mint/transaction"
+)
+
+// handle processes client request
+func (n *Node) handle(c *client, req incomingRequest) {
+	switch req.Method {
+
+	case "get_blockchain_info":
+		n.replyResult(c, req, n.blockchainInfo())
+
+	case "get_blockchain_state":
+		n.replyResult(c, req, n.blockchainState())
+
+	case "get_wallet_state":
+		params := struct {
+			PublicKey string `json:"public_key"`
+		}{}
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			n.replyError(c, req.Method, req.ID, rpc.EMalformedRequest, err.Error())
+			return
+		}
+		pub, err := mint.ParsePublicKey(params.PublicKey)
+		if err != nil {
+			n.replyError(c, req.Method, req.ID, rpc.EMalformedRequest, err.Error())
+			return
+		}
+		n.replyResult(c, req, n.WalletState(pub))
+
+	case "get_block":
+		params := struct {
+			ID     string `json:"id"`
+			Digest string `json:"digest"`
+		}{}
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			n.replyError(c, req.Method, req.ID, rpc.EMalformedRequest, err.Error())
+			return
+		}
+		b, ok := n.block(params.ID, params.Digest)
+		if !ok {
+			n.replyError(c, req.Method, req.ID, rpc.EBlockNotFound, "block not found")
+			return
+		}
+		n.replyResult(c, req, hex.EncodeToString(b.data))
+
+	case "add_transaction":
+		params := struct {
+			Name string `json:"name"`
+			Data string `json:"data"`
+		}{}
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			n.replyError(c, req.Method, req.ID, rpc.EMalformedRequest, err.Error())
+			return
+		}
+		if rej := n.addTransaction(params.Name, params.Data); rej != nil {
+			n.replyError(c, req.Method, req.ID, rej.code, rej.desc)
+			return
+		}
+		res := request.AddedTransaction{}
+		res.TransactionPool.PendingCapacity = maxNonceDelta
+		res.TransactionPool.VotingCapacity = maxBlockTransactions
+		n.replyResult(c, req, res)
+
+	default:
+		n.replyError(c, req.Method, req.ID, rpc.EMethodNotFound, "method not found")
+	}
+}
+
+// blockchainInfo makes get_blockchain_info result
+func (n *Node) blockchainInfo() request.BlockchainInfo {
+	ret := request.BlockchainInfo{
+		BlockchainVersion: 1,
+		ClientAPIVersion:  1,
+		SupportedTransactions: []string{
+			transaction.SetWalletTagTx.String(),
+			transaction.UnsetWalletTagTx.String(),
+			transaction.TransferAssetTx.String(),
+		},
+		SupportedAssets: []string{
+			mint.TokenGOLD.String(),
+			mint.TokenMNT.String(),
+		},
+		SupportedWalletTags: make([]string, 0),
+	}
+	for _, t := range mint.WalletTagToString {
+		ret.SupportedWalletTags = append(ret.SupportedWalletTags, t)
+	}
+	return ret
+}
+
+// blockchainState makes get_blockchain_state result
+func (n *Node) blockchainState() request.BlockchainState {
+	n.chainLock.Lock()
+	defer n.chainLock.Unlock()
+
+	gold, mnt := new(big.Int), new(big.Int)
+	for _, w := range n.wallets {
+		gold.Add(gold, w.gold)
+		mnt.Add(mnt, w.mnt)
+	}
+	latest := n.blocks[len(n.blocks)-1]
+
+	ret := request.BlockchainState{
+		LastBlockDigest:  latest.digest,
+		BlockCount:       &request.BigInt{Int: big.NewInt(int64(len(n.blocks)))},
+		TransactionCount: &request.BigInt{Int: new(big.Int).SetUint64(n.txCount)},
+		WalletCount:      &request.BigInt{Int: big.NewInt(int64(len(n.wallets)))},
+		NodeCount:        1,
+		Balance: request.Balance{
+			Gold: amount.FromBig(gold),
+			Mnt:  amount.FromBig(mnt),
+		},
+	}
+	ret.Node.BlockchainState = "synchronized"
+	ret.Node.SyncState = "synchronized"
+	ret.Node.TransactionPool.PendingCount = len(n.pool)
+	ret.Node.TransactionPool.PendingCapacity = maxNonceDelta
+	ret.Node.TransactionPool.VotingCapacity = maxBlockTransactions
+	return ret
+}
+
+// block finds a mined block by ID or digest
+func (n *Node) block(id, digest string) (*minedBlock, bool) {
+	n.chainLock.Lock()
+	defer n.chainLock.Unlock()
+
+	if id != "" {
+		x, ok := new(big.Int).SetString(id, 10)
+		if !ok || x.Sign() < 0 || !x.IsInt64() || x.Int64() >= int64(len(n.blocks)) {
+			return nil, false
+		}
+		return n.blocks[x.Int64()], true
+	}
+
+	d, err := mint.ParseDigest(digest)
+	if err != nil {
+		return nil, false
+	}
+	for _, b := range n.blocks {
+		if b.digest == d {
+			return b, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,365 @@
+package fakenode_test
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/alert"
+	"github.com/void616/gm.mint.sender/internal/mint/blockobserver"
+	"github.com/void616/gm.mint.sender/internal/mint/blockparser"
+	"github.com/void616/gm.mint.sender/internal/mint/fakenode"
+	"github.com/void616/gm.mint.sender/internal/mint/rpcpool"
+	"github.com/void616/gm.mint.sender/internal/mint/txfilter"
+	"github.com/void616/gm.mint.sender/internal/retry"
+	"github.com/void616/gm.mint.sender/internal/sender/db/sqlite"
+	"github.com/void616/gm.mint.sender/internal/sender/db/types"
+	"github.com/void616/gm.mint.sender/internal/sender/keys"
+	"github.com/void616/gm.mint.sender/internal/sender/notifier"
+	"github.com/void616/gm.mint.sender/internal/sender/txconfirmer"
+	"github.com/void616/gm.mint.sender/internal/sender/txsigner"
+	"github.com/void616/gm.mint.sender/internal/wakeup"
+	"github.com/void616/gm.mint/amount"
+	"github.com/void616/gm.mint/signer"
+	"github.com/void616/gm.mint/transaction"
+	"github.com/void616/gotask"
+)
+
+// Sending is signed and posted, mined into a block, parsed and confirmed
+func TestSendingConfirmed(t *testing.T) {
+	node, from, to := newNode(t)
+	snd := newSender(t, node, from)
+
+	putSending(t, snd, "test", "1", to, "1.5")
+	waitStatus(t, snd, "test", "1", types.SendingPosted)
+
+	block, err := node.Mine()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := mineStatus(t, node, snd, "test", "1", types.SendingConfirmed)
+	if s.Block == nil || s.Block.Cmp(block) != 0 {
+		t.Fatalf("sending is confirmed in block %v, expected %v", s.Block, block)
+	}
+	if s.Digest == nil {
+		t.Fatal("sending digest is empty")
+	}
+
+	if b := node.WalletState(to).Balance.Gold; b.String() != amount.MustFromString("1.5").String() {
+		t.Fatalf("recipient balance is %v", b.String())
+	}
+}
+
+// Block with the sending is replaced, the sending is reverted, posted again and confirmed in a new block
+func TestSendingReorganization(t *testing.T) {
+	node, from, to := newNode(t)
+	snd := newSender(t, node, from)
+
+	putSending(t, snd, "test", "1", to, "1")
+	waitStatus(t, snd, "test", "1", types.SendingPosted)
+
+	block, err := node.Mine()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mineStatus(t, node, snd, "test", "1", types.SendingConfirmed)
+
+	// replace the block, the transaction is lost
+	if err := node.Reorganize(new(big.Int).Sub(block, big.NewInt(1))); err != nil {
+		t.Fatal(err)
+	}
+	s := mineStatus(t, node, snd, "test", "1", types.SendingConfirmed, func(s *types.Sending) bool {
+		return s.Block != nil && s.Block.Cmp(block) > 0
+	})
+	if s.Block.Cmp(node.LatestBlock()) > 0 {
+		t.Fatalf("sending is confirmed in unknown block %v", s.Block)
+	}
+
+	// sent once
+	if b := node.WalletState(to).Balance.Gold; b.String() != amount.MustFromString("1").String() {
+		t.Fatalf("recipient balance is %v", b.String())
+	}
+}
+
+// Failed notification is retried and delivered once, exhausted retries move the notification to dead letters
+func TestSendingNotification(t *testing.T) {
+	node, from, to := newNode(t)
+	snd := newSender(t, node, from)
+
+	trans := &natsTransporter{
+		fails: map[string]int{"retried": 2, "dead": -1},
+		sent:  make(map[string]int),
+	}
+	n, err := notifier.New(snd.dao, trans, nil, snd.confirmer, &alert.Null{}, testLogger().WithField("task", "notifier"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	n.AddWakeup(snd.wakeup)
+	// retry immediately
+	n.AddRetry(&retry.Policies{
+		Default: &retry.Policy{
+			Backoff: []retry.Step{{Delay: 0}},
+		},
+		Services: map[string]*retry.Policy{
+			"dead": {
+				MaxAttempts: 2,
+				Backoff:     []retry.Step{{Delay: 0}},
+			},
+		},
+	})
+	run(t, "notifier", n.Task)
+
+	putSending(t, snd, "retried", "1", to, "1")
+	putSending(t, snd, "dead", "1", to, "1")
+	waitStatus(t, snd, "retried", "1", types.SendingPosted)
+	waitStatus(t, snd, "dead", "1", types.SendingPosted)
+	if _, err := node.Mine(); err != nil {
+		t.Fatal(err)
+	}
+
+	s := mineStatus(t, node, snd, "retried", "1", types.SendingConfirmed, func(s *types.Sending) bool {
+		return s.Notified
+	})
+	if s.NotifyAttempts != 3 || s.DeadLetter {
+		t.Fatalf("notification is delivered in %v attempts, dead letter %v", s.NotifyAttempts, s.DeadLetter)
+	}
+	if v := trans.delivered("retried"); v != 1 {
+		t.Fatalf("notification is delivered %v times", v)
+	}
+
+	s = waitStatus(t, snd, "dead", "1", types.SendingConfirmed, func(s *types.Sending) bool {
+		return s.DeadLetter
+	})
+	if s.NotifyAttempts != 2 || s.Notified {
+		t.Fatalf("dead notification is attempted %v times, notified %v", s.NotifyAttempts, s.Notified)
+	}
+}
+
+// sender is the sending pipeline of the sender service running against the fake node
+type sender struct {
+	dao       *sqlite.Database
+	wakeup    *wakeup.Bus
+	confirmer *txconfirmer.Confirmer
+}
+
+// newNode runs a fake node with a funded signer wallet and an approved recipient wallet
+func newNode(t *testing.T) (*fakenode.Node, keys.Signer, mint.PublicKey) {
+	node, err := fakenode.New("127.0.0.1:0", 0, testLogger().WithField("task", "node"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	run(t, "node", node.Task)
+
+	sig, err := signer.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rcpt, err := signer.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	node.SetBalance(sig.PublicKey(), mint.TokenGOLD, amount.MustFromString("100"))
+	node.SetBalance(sig.PublicKey(), mint.TokenMNT, amount.MustFromString("100"))
+	node.SetTag(sig.PublicKey(), mint.WalletTagApproved)
+	node.SetTag(rcpt.PublicKey(), mint.WalletTagApproved)
+	return node, keys.NewLocal(sig.PrivateKey()), rcpt.PublicKey()
+}
+
+// newSender runs the sender pipeline: signer, block observer, tx filter and tx confirmer
+func newSender(t *testing.T, node *fakenode.Node, signers ...keys.Signer) *sender {
+	logger := testLogger()
+
+	pool, closePool, err := rpcpool.New(node.Addr())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(closePool)
+
+	dao, err := sqlite.New("file:"+t.Name()+"?mode=memory&cache=shared", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { dao.Close() })
+	if err := dao.Migrate(); err != nil {
+		t.Fatal(err)
+	}
+
+	bus := wakeup.New()
+	latest := node.LatestBlock()
+
+	parsedTX := make(chan *blockparser.Transaction, 256)
+	filteredTX := make(chan *blockparser.Transaction, 256)
+	parsedBlock := make(chan *big.Int)
+	reorg := make(chan *blockparser.Reorg)
+	checkpointsToFilter, checkpointsFiltered := make(chan *big.Int, 1), make(chan *big.Int, 1)
+
+	observer, err := blockobserver.New(latest, pool, parsedTX, parsedBlock, reorg, &alert.Null{}, logger.WithField("task", "block_observer"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	filter, err := txfilter.New(
+		parsedTX, filteredTX,
+		make(chan mint.PublicKey), make(chan mint.PublicKey),
+		func(typ transaction.Code, outgoing bool) bool {
+			return outgoing && (typ == transaction.TransferAssetTx || typ == transaction.SetWalletTagTx)
+		},
+		logger.WithField("task", "tx_filter"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	filter.AddCheckpoints(checkpointsToFilter, checkpointsFiltered)
+	for _, s := range signers {
+		filter.AddWallet(s.PublicKey())
+	}
+
+	confirmer, err := txconfirmer.New(filteredTX, parsedBlock, reorg, latest, 0, dao, &alert.Null{}, logger.WithField("task", "tx_confirmer"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	confirmer.AddWakeup(bus)
+	confirmer.AddCheckpoints(checkpointsToFilter, checkpointsFiltered)
+
+	sig, err := txsigner.New(pool, dao, signers, 0, &alert.Null{}, logger.WithField("task", "tx_signer"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig.AddWakeup(bus)
+
+	// stopped in reverse order
+	run(t, "tx_confirmer", confirmer.Task)
+	run(t, "tx_filter", filter.Task)
+	run(t, "tx_signer", sig.Task)
+	run(t, "block_observer", observer.Task)
+
+	return &sender{
+		dao:       dao,
+		wakeup:    bus,
+		confirmer: confirmer,
+	}
+}
+
+// putSending enqueues a sending of GOLD
+func putSending(t *testing.T, snd *sender, service, id string, to mint.PublicKey, amo string) {
+	err := snd.dao.PutSending(&types.Sending{
+		Transport: types.SendingNats,
+		Status:    types.SendingEnqueued,
+		To:        to,
+		Token:     mint.TokenGOLD,
+		Amount:    amount.MustFromString(amo),
+		Service:   service,
+		RequestID: id,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	snd.wakeup.Kick(wakeup.Signing)
+}
+
+// waitStatus waits until the sending has the status (and matches the conditions)
+func waitStatus(t *testing.T, snd *sender, service, id string, status types.SendingStatus, cond ...func(*types.Sending) bool) *types.Sending {
+	t.Helper()
+	return wait(t, nil, snd, service, id, status, cond...)
+}
+
+// mineStatus waits until the sending has the status (and matches the conditions) mining empty blocks meanwhile
+func mineStatus(t *testing.T, node *fakenode.Node, snd *sender, service, id string, status types.SendingStatus, cond ...func(*types.Sending) bool) *types.Sending {
+	t.Helper()
+	return wait(t, node, snd, service, id, status, cond...)
+}
+
+func wait(t *testing.T, node *fakenode.Node, snd *sender, service, id string, status types.SendingStatus, cond ...func(*types.Sending) bool) *types.Sending {
+	t.Helper()
+	deadline := time.Now().Add(time.Second * 30)
+	minedAt := time.Now()
+	for time.Now().Before(deadline) {
+		// the observer could miss a block event before it's subscribed
+		if node != nil && time.Since(minedAt) >= time.Millisecond*500 {
+			if _, err := node.Mine(); err != nil {
+				t.Fatal(err)
+			}
+			minedAt = time.Now()
+		}
+		s, err := snd.dao.GetSending(service, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ok := s != nil && s.Status == status
+		for _, c := range cond {
+			ok = ok && c(s)
+		}
+		if ok {
+			return s
+		}
+		time.Sleep(time.Millisecond * 50)
+	}
+	t.Fatalf("sending %v/%v doesn't get status %v in time", service, id, status)
+	return nil
+}
+
+// run runs the task until the test is finished
+func run(t *testing.T, name string, routine func(*gotask.Token)) {
+	task, err := gotask.NewTask(name, routine)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, waiter, err := task.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		token.Stop()
+		waiter.Wait()
+	})
+}
+
+// testLogger is quiet unless tests are verbose
+func testLogger() *logrus.Entry {
+	l := logrus.New()
+	l.SetLevel(logrus.ErrorLevel)
+	if testing.Verbose() {
+		l.SetLevel(logrus.DebugLevel)
+	}
+	return logrus.NewEntry(l)
+}
+
+// natsTransporter fails the specified number of deliveries by service (forever if negative)
+type natsTransporter struct {
+	lock  sync.Mutex
+	fails map[string]int
+	sent  map[string]int
+}
+
+func (n *natsTransporter) deliver(service string) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if f := n.fails[service]; f != 0 {
+		if f > 0 {
+			n.fails[service] = f - 1
+		}
+		return errors.New("delivery failed")
+	}
+	n.sent[service]++
+	return nil
+}
+
+func (n *natsTransporter) delivered(service string) int {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.sent[service]
+}
+
+// PublishSentEvent implementation
+func (n *natsTransporter) PublishSentEvent(ok bool, err string, service, id string, to mint.PublicKey, t mint.Token, a *amount.Amount, d *mint.Digest, confirmations uint64) error {
+	return n.deliver(service)
+}
+
+// PublishApprovedEvent implementation
+func (n *natsTransporter) PublishApprovedEvent(ok bool, err string, service, id string, to mint.PublicKey, d *mint.Digest, confirmations uint64) error {
+	return n.deliver(service)
+}
@@ -0,0 +1,187 @@
+package fakenode
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/void616/gm.mint.rpc/rpc"
+	"github.com/void616/gotask"
+)
+
+// terminator of a message on the wire
+const terminator byte = 0
+
+// client is a connected RPC client
+type client struct {
+	conn      net.Conn
+	writeLock sync.Mutex
+}
+
+// incomingRequest is a request from the client
+type incomingRequest struct {
+	Method string          `json:"method"`
+	ID     uint32          `json:"id"`
+	Params json.RawMessage `json:"params"`
+}
+
+// Task loop
+func (n *Node) Task(token *gotask.Token) {
+	var wg sync.WaitGroup
+
+	// accept connections
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			conn, err := n.listener.Accept()
+			if err != nil {
+				if !token.Stopped() {
+					n.logger.WithError(err).Error("Failed to accept connection")
+				}
+				return
+			}
+			c := &client{conn: conn}
+			n.clientsLock.Lock()
+			n.clients[c] = struct{}{}
+			n.clientsLock.Unlock()
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				n.serve(c)
+			}()
+		}
+	}()
+
+	// mine
+	lastMined := time.Now()
+	for !token.Stopped() {
+		if n.interval > 0 && time.Since(lastMined) >= n.interval {
+			if _, err := n.Mine(); err != nil {
+				n.logger.WithError(err).Error("Failed to mine block")
+			}
+			lastMined = time.Now()
+		}
+		token.Sleep(time.Millisecond * 100)
+	}
+
+	n.listener.Close()
+	n.clientsLock.Lock()
+	for c := range n.clients {
+		c.conn.Close()
+	}
+	n.clientsLock.Unlock()
+	wg.Wait()
+}
+
+// serve reads client requests until the connection is closed
+func (n *Node) serve(c *client) {
+	defer func() {
+		c.conn.Close()
+		n.clientsLock.Lock()
+		delete(n.clients, c)
+		n.clientsLock.Unlock()
+	}()
+
+	rd := bufio.NewReader(c.conn)
+	for {
+		msg, err := rd.ReadBytes(terminator)
+		if err != nil {
+			return
+		}
+		if len(msg) <= 1 {
+			continue
+		}
+		msg = msg[:len(msg)-1]
+
+		req := incomingRequest{}
+		if err := json.Unmarshal(msg, &req); err != nil {
+			n.replyError(c, "", 0, rpc.EInvalidJSON, err.Error())
+			continue
+		}
+		n.handle(c, req)
+	}
+}
+
+// replyResult sends a result
+func (n *Node) replyResult(c *client, req incomingRequest, result interface{}) {
+	b, err := json.Marshal(result)
+	if err != nil {
+		n.logger.WithError(err).Error("Failed to marshal result")
+		n.replyError(c, req.Method, req.ID, rpc.EUnclassified, err.Error())
+		return
+	}
+	n.write(c, &rpc.Result{
+		Method: req.Method,
+		ID:     req.ID,
+		Result: b,
+	})
+}
+
+// replyError sends an error with a reason
+func (n *Node) replyError(c *client, method string, id uint32, code rpc.ErrorCode, desc string) {
+	m := &rpc.Error{
+		Method: method,
+		ID:     id,
+	}
+	m.Error.Code = code
+	m.Error.Desc = desc
+	m.Error.Reason = &rpc.ErrorReason{
+		Code: code,
+		Desc: desc,
+	}
+	n.write(c, m)
+}
+
+// broadcastBlocksSynchronized notifies all the clients about a new block
+func (n *Node) broadcastBlocksSynchronized(b *minedBlock) {
+	params, err := json.Marshal(struct {
+		Count      string `json:"count"`
+		LastID     string `json:"last_id"`
+		LastDigest string `json:"last_digest"`
+	}{
+		Count:      "1",
+		LastID:     b.id.String(),
+		LastDigest: b.digest.String(),
+	})
+	if err != nil {
+		n.logger.WithError(err).Error("Failed to marshal event")
+		return
+	}
+	evt := &rpc.Event{
+		Method: "blocks_synchronized",
+		Params: params,
+	}
+
+	n.clientsLock.Lock()
+	clients := make([]*client, 0, len(n.clients))
+	for c := range n.clients {
+		clients = append(clients, c)
+	}
+	n.clientsLock.Unlock()
+
+	for _, c := range clients {
+		n.write(c, evt)
+	}
+}
+
+// write sends a message to the client
+func (n *Node) write(c *client, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		n.logger.WithError(err).Error("Failed to marshal message")
+		return
+	}
+
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+
+	c.conn.SetWriteDeadline(time.Now().Add(time.Second * 10))
+	if _, err := c.conn.Write(append(b, terminator)); err != nil {
+		n.logger.WithError(err).Debug("Failed to write message")
+		c.conn.Close()
+	}
+}
@@ -1,6 +1,7 @@
 package txfilter
 
 import (
+	"math/big"
 	"time"
 
 	"github.com/void616/gm.mint.sender/internal/mint/blockparser"
@@ -17,6 +18,7 @@ func (f *Filter) Task(token *gotask.Token) {
 		f.roiLock.Lock()
 
 		// get incoming parsed transactions, filter
+		var checkpoint *big.Int
 		{
 			buf = buf[0:0]
 			leave := false
@@ -28,10 +30,22 @@ func (f *Filter) Task(token *gotask.Token) {
 					if f.roiCheck(tx) {
 						buf = append(buf, tx)
 					}
+				case checkpoint = <-f.checkpointIn:
+					leave = true
 				case <-time.After(time.Second):
 					leave = true
 				}
 			}
+			// transactions published before the checkpoint are buffered in the channel already
+			if checkpoint != nil {
+				for n := len(f.in); n > 0; n-- {
+					tx := <-f.in
+					gotTotal++
+					if f.roiCheck(tx) {
+						buf = append(buf, tx)
+					}
+				}
+			}
 			if gotTotal > 0 {
 				if len(buf) > 0 {
 					f.logger.Infof("Filtered %v from %v transactions", len(buf), gotTotal)
@@ -58,6 +72,9 @@ func (f *Filter) Task(token *gotask.Token) {
 
 				f.logger.Debugf("Flushed %v transactions", len(buf))
 			}
+			if checkpoint != nil {
+				f.checkpointOut <- checkpoint
+			}
 		}
 
 		// add wallets to roi
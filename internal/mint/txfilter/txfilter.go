@@ -1,6 +1,7 @@
 package txfilter
 
 import (
+	"math/big"
 	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -23,6 +24,9 @@ type Filter struct {
 	roiWallets map[mint.PublicKey]struct{}
 	txFilter   TxFilter
 	metrics    *Metrics
+
+	checkpointIn  <-chan *big.Int
+	checkpointOut chan<- *big.Int
 }
 
 // TxFilter filters transaction
@@ -61,6 +65,13 @@ func (f *Filter) AddWallet(pubkey ...mint.PublicKey) {
 	}
 }
 
+// AddCheckpoints passes block IDs from `in` to `out` once transactions received before are filtered and emitted.
+// Should be called before service launch
+func (f *Filter) AddCheckpoints(in <-chan *big.Int, out chan<- *big.Int) {
+	f.checkpointIn = in
+	f.checkpointOut = out
+}
+
 // Metrics data
 type Metrics struct {
 	ROIWallets prometheus.Gauge
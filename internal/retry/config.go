@@ -0,0 +1,62 @@
+package retry
+
+import (
+	"fmt"
+	"time"
+)
+
+// Config of policies by service name as it's read from the services config file, durations are in seconds
+type Config struct {
+	Default  PolicyConfig            `yaml:"default"`
+	Services map[string]PolicyConfig `yaml:"services"`
+}
+
+// PolicyConfig is a config of a single policy, empty backoff means the default one (see Default())
+type PolicyConfig struct {
+	MaxAttempts uint32 `yaml:"max_attempts"`
+	MaxAge      uint   `yaml:"max_age"`
+	Backoff     []struct {
+		Until uint `yaml:"until"`
+		Delay uint `yaml:"delay"`
+	} `yaml:"backoff"`
+}
+
+// FromConfig gets policies from the config, service policy overrides default one completely
+func FromConfig(c *Config) (*Policies, error) {
+	def, err := c.Default.policy()
+	if err != nil {
+		return nil, err
+	}
+	ps := &Policies{
+		Default:  def,
+		Services: make(map[string]*Policy),
+	}
+	for name, pc := range c.Services {
+		p, err := pc.policy()
+		if err != nil {
+			return nil, fmt.Errorf("service %v: %v", name, err)
+		}
+		ps.Services[name] = p
+	}
+	return ps, nil
+}
+
+// policy makes a policy from the config
+func (c *PolicyConfig) policy() (*Policy, error) {
+	p := Default()
+	p.MaxAttempts = c.MaxAttempts
+	p.MaxAge = time.Second * time.Duration(c.MaxAge)
+	if len(c.Backoff) > 0 {
+		p.Backoff = make([]Step, len(c.Backoff))
+		for i, v := range c.Backoff {
+			if v.Delay == 0 {
+				return nil, fmt.Errorf("backoff delay is not specified")
+			}
+			p.Backoff[i] = Step{
+				Until: time.Second * time.Duration(v.Until),
+				Delay: time.Second * time.Duration(v.Delay),
+			}
+		}
+	}
+	return p, nil
+}
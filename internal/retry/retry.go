@@ -0,0 +1,73 @@
+// Package retry describes notification delivery retries
+package retry
+
+import (
+	"time"
+)
+
+// Policy of notification delivery retries
+type Policy struct {
+	// MaxAttempts is max number of delivery attempts (unlimited if zero)
+	MaxAttempts uint32
+	// MaxAge is max time since the first delivery attempt (unlimited if zero)
+	MaxAge time.Duration
+	// Backoff is a curve of delays between attempts, last step lasts forever
+	Backoff []Step
+}
+
+// Step of backoff curve: Delay between attempts until Until passed since the first attempt
+type Step struct {
+	Until time.Duration
+	Delay time.Duration
+}
+
+// Default policy: for 5m every 1m, then for 30m every 5m, then for 60m every 10m, then every 120m
+func Default() *Policy {
+	return &Policy{
+		Backoff: []Step{
+			{Until: time.Minute * 5, Delay: time.Minute},
+			{Until: time.Minute * 35, Delay: time.Minute * 5},
+			{Until: time.Minute * 95, Delay: time.Minute * 10},
+			{Delay: time.Minute * 120},
+		},
+	}
+}
+
+// Next gets time of the next delivery attempt after failed attempt, false means retries are exhausted
+func (p *Policy) Next(first time.Time, attempts uint32, now time.Time) (time.Time, bool) {
+	elapsed := now.Sub(first)
+	if p.MaxAttempts > 0 && attempts >= p.MaxAttempts {
+		return now, false
+	}
+	if p.MaxAge > 0 && elapsed >= p.MaxAge {
+		return now, false
+	}
+	delay := time.Minute
+	for _, s := range p.Backoff {
+		delay = s.Delay
+		if s.Until <= 0 || elapsed < s.Until {
+			break
+		}
+	}
+	return now.Add(delay), true
+}
+
+// Policies by service name
+type Policies struct {
+	// Default policy of services without own policy (see Default() if nil)
+	Default  *Policy
+	Services map[string]*Policy
+}
+
+// Get policy of the service
+func (p *Policies) Get(service string) *Policy {
+	if p != nil {
+		if v, ok := p.Services[service]; ok && v != nil {
+			return v
+		}
+		if p.Default != nil {
+			return p.Default
+		}
+	}
+	return Default()
+}
@@ -0,0 +1,97 @@
+package retry
+
+import (
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+func TestDefault(t *testing.T) {
+	// for 5m every 1m, then for 30m every 5m, then for 60m every 10m, then every 120m
+	schedule := []struct {
+		elapsed time.Duration
+		delay   time.Duration
+	}{
+		{0, time.Minute},
+		{time.Minute*5 - time.Second, time.Minute},
+		{time.Minute * 5, time.Minute * 5},
+		{time.Minute*35 - time.Second, time.Minute * 5},
+		{time.Minute * 35, time.Minute * 10},
+		{time.Minute*95 - time.Second, time.Minute * 10},
+		{time.Minute * 95, time.Minute * 120},
+		{time.Hour * 24 * 30, time.Minute * 120},
+	}
+
+	first := time.Now()
+	p := Default()
+	for _, s := range schedule {
+		now := first.Add(s.elapsed)
+		next, ok := p.Next(first, 1000, now)
+		if !ok {
+			t.Fatalf("retries are exhausted after %v", s.elapsed)
+		}
+		if next.Sub(now) != s.delay {
+			t.Fatalf("delay after %v is %v, expected %v", s.elapsed, next.Sub(now), s.delay)
+		}
+	}
+}
+
+func TestFromConfig(t *testing.T) {
+	c := Config{}
+	err := yaml.Unmarshal([]byte(`
+default:
+  max_attempts: 10
+services:
+  fast:
+    max_age: 3600
+    backoff:
+      - until: 60
+        delay: 10
+      - delay: 300
+`), &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps, err := FromConfig(&c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// default backoff with own limits
+	def := ps.Get("other")
+	if def.MaxAttempts != 10 || def.MaxAge != 0 || len(def.Backoff) != len(Default().Backoff) {
+		t.Fatalf("unexpected default policy %+v", def)
+	}
+
+	// service policy overrides default one completely
+	fast := ps.Get("fast")
+	if fast.MaxAttempts != 0 || fast.MaxAge != time.Hour {
+		t.Fatalf("unexpected service policy %+v", fast)
+	}
+	first := time.Now()
+	if next, _ := fast.Next(first, 1, first.Add(time.Second*30)); next.Sub(first) != time.Second*40 {
+		t.Fatalf("unexpected first step delay %v", next.Sub(first))
+	}
+	if next, _ := fast.Next(first, 1, first.Add(time.Minute)); next.Sub(first) != time.Minute*6 {
+		t.Fatalf("unexpected last step delay %v", next.Sub(first))
+	}
+	if _, ok := fast.Next(first, 1, first.Add(time.Hour)); ok {
+		t.Fatal("retries are not exhausted by max age")
+	}
+
+	// missing delay
+	c.Services["fast"].Backoff[0].Delay = 0
+	if _, err := FromConfig(&c); err == nil {
+		t.Fatal("missing backoff delay is accepted")
+	}
+
+	// empty config is the default policy
+	ps, err = FromConfig(&Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := ps.Get("any"); p.MaxAttempts != 0 || p.MaxAge != 0 || len(p.Backoff) != len(Default().Backoff) {
+		t.Fatalf("unexpected policy of empty config %+v", p)
+	}
+}
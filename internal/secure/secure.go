@@ -0,0 +1,111 @@
+// Package secure builds TLS configs of API servers and Nats connection options
+package secure
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+
+	gonats "github.com/nats-io/nats.go"
+)
+
+// ServerTLS gets TLS config of a server with the certificate.
+// Client certificate is required and verified against clientCAFile unless it's empty.
+func ServerTLS(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	if certFile == "" || keyFile == "" {
+		return nil, fmt.Errorf("certificate and key are required")
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load certificate: %v", err)
+	}
+	c := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if clientCAFile != "" {
+		pool, err := loadPool(clientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		c.ClientCAs = pool
+		c.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return c, nil
+}
+
+// ClientTLS gets TLS config of a client.
+// Server certificate is verified against caFile (system pool if empty), client certificate is optional.
+func ClientTLS(caFile, certFile, keyFile string) (*tls.Config, error) {
+	c := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if caFile != "" {
+		pool, err := loadPool(caFile)
+		if err != nil {
+			return nil, err
+		}
+		c.RootCAs = pool
+	}
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %v", err)
+		}
+		c.Certificates = []tls.Certificate{cert}
+	}
+	return c, nil
+}
+
+// Nats describes security of Nats connection, empty values are ignored
+type Nats struct {
+	// TLS enables TLS with CA to verify the server and client certificate (optional)
+	TLS  bool
+	CA   string
+	Cert string
+	Key  string
+	// Creds is a path to user credentials file (JWT and NKey seed)
+	Creds string
+	// NKey is a path to NKey seed file
+	NKey string
+}
+
+// Options gets Nats connection options
+func (n Nats) Options() ([]gonats.Option, error) {
+	var opts []gonats.Option
+	if n.Creds != "" && n.NKey != "" {
+		return nil, fmt.Errorf("credentials and nkey are mutually exclusive")
+	}
+	if n.TLS || n.CA != "" || n.Cert != "" || n.Key != "" {
+		c, err := ClientTLS(n.CA, n.Cert, n.Key)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, gonats.Secure(c))
+	}
+	if n.Creds != "" {
+		opts = append(opts, gonats.UserCredentials(n.Creds))
+	}
+	if n.NKey != "" {
+		o, err := gonats.NkeyOptionFromSeed(n.NKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load nkey: %v", err)
+		}
+		opts = append(opts, o)
+	}
+	return opts, nil
+}
+
+// loadPool loads PEM encoded certificates into a new pool
+func loadPool(file string) (*x509.CertPool, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA: %v", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(b) {
+		return nil, fmt.Errorf("no certificates found in %v", file)
+	}
+	return pool, nil
+}
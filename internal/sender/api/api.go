@@ -1,9 +1,12 @@
 package api
 
 import (
+	"sync"
+
 	"github.com/sirupsen/logrus"
 	"github.com/void616/gm.mint.sender/internal/mint/rpcpool"
 	"github.com/void616/gm.mint.sender/internal/sender/db"
+	"github.com/void616/gm.mint.sender/internal/wakeup"
 )
 
 // API provides methods to enqueue sending
@@ -11,6 +14,11 @@ type API struct {
 	logger *logrus.Entry
 	dao    db.DAO
 	pool   *rpcpool.Pool
+
+	policies   map[string]*Policy
+	policyLock sync.Mutex
+	approval   *Approval
+	wakeup     *wakeup.Bus
 }
 
 // New instance
@@ -26,3 +34,8 @@ func New(
 	}
 	return f, nil
 }
+
+// AddWakeup sets a bus to kick the signer and the notifier once requests are written and should be called before service launch
+func (a *API) AddWakeup(b *wakeup.Bus) {
+	a.wakeup = b
+}
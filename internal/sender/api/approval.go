@@ -0,0 +1,163 @@
+package api
+
+import (
+	"time"
+
+	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/sender/db/types"
+	"github.com/void616/gm.mint.sender/internal/wakeup"
+	"github.com/void616/gm.mint/amount"
+)
+
+// Approval requires approval of large sendings by administrators
+type Approval struct {
+	// Thresholds by token, sending of the amount (or above) awaits approval, missing token is not checked
+	Thresholds map[mint.Token]*amount.Amount
+	// Approvers is a number of distinct administrators to approve a sending (one by default)
+	Approvers uint
+}
+
+// AddApproval requires approval of large sendings and should be called before service launch
+func (a *API) AddApproval(v *Approval) {
+	a.approval = v
+}
+
+// requireApproval parks enqueued sending awaiting approval if it's amount reaches the threshold
+func (a *API) requireApproval(snd *types.Sending) {
+	if a.approval == nil || snd.Status != types.SendingEnqueued {
+		return
+	}
+	th, ok := a.approval.Thresholds[snd.Token]
+	if !ok || th == nil || snd.Amount.Value.Cmp(th.Value) < 0 {
+		return
+	}
+	snd.Status = types.SendingAwaitingApproval
+}
+
+// ListPendingSendings gets a list of sendings awaiting approval or held, with names of administrators approved them
+func (a *API) ListPendingSendings(max uint16) (list []*types.Sending, approvers [][]string, success bool) {
+	list, err := a.dao.ListPendingSendings(max)
+	if err != nil {
+		a.logger.WithError(err).Error("Failed to get pending sendings")
+		return nil, nil, false
+	}
+	approvers = make([][]string, len(list))
+	for i, snd := range list {
+		names, ok := a.sendingApprovers(snd.ID)
+		if !ok {
+			return nil, nil, false
+		}
+		approvers[i] = names
+	}
+	return list, approvers, true
+}
+
+// ApproveSending approves pending sending on behalf of the administrator, sending gets enqueued once approved by enough distinct administrators
+// (snd is nil if there is no such request, accepted is false if request is not pending anymore)
+func (a *API) ApproveSending(service, id, approver string) (snd *types.Sending, approvers []string, accepted, success bool) {
+	snd, err := a.dao.GetSending(service, id)
+	if err != nil {
+		a.logger.WithError(err).Error("Failed to get sending")
+		return nil, nil, false, false
+	}
+	if snd == nil {
+		return nil, nil, false, true
+	}
+	if snd.Status != types.SendingAwaitingApproval && snd.Status != types.SendingHeld {
+		return snd, nil, false, true
+	}
+
+	// repeated approval by the same administrator is ignored
+	if err := a.dao.PutSendingApproval(&types.SendingApproval{
+		SendingID: snd.ID,
+		Approver:  approver,
+		CreatedAt: time.Now().UTC(),
+	}); err != nil && !a.dao.DuplicateError(err) {
+		a.logger.WithError(err).Error("Failed to save sending approval")
+		return nil, nil, false, false
+	}
+
+	approvers, ok := a.sendingApprovers(snd.ID)
+	if !ok {
+		return nil, nil, false, false
+	}
+
+	a.logger.
+		WithField("service", service).
+		WithField("id", id).
+		WithField("approvers", approvers).
+		Infof("Sending is approved by %v", approver)
+
+	required := 1
+	if a.approval != nil && a.approval.Approvers > 1 {
+		required = int(a.approval.Approvers)
+	}
+	if len(approvers) < required {
+		return snd, approvers, true, true
+	}
+
+	enqueued, err := a.dao.SetSendingApproved(snd.ID)
+	if err != nil {
+		a.logger.WithError(err).Error("Failed to enqueue approved sending")
+		return nil, nil, false, false
+	}
+	if !enqueued {
+		// cancelled or rejected meanwhile
+		snd, err = a.dao.GetSending(service, id)
+		if err != nil {
+			a.logger.WithError(err).Error("Failed to get sending")
+			return nil, nil, false, false
+		}
+		return snd, approvers, false, true
+	}
+	a.wakeup.Kick(wakeup.Signing)
+	snd.Status = types.SendingEnqueued
+	return snd, approvers, true, true
+}
+
+// RejectSending rejects pending sending on behalf of the administrator, requestor gets notified with the reason
+// (snd is nil if there is no such request, rejected is false if request is not pending anymore)
+func (a *API) RejectSending(service, id, approver, reason string) (snd *types.Sending, rejected, success bool) {
+	snd, err := a.dao.GetSending(service, id)
+	if err != nil {
+		a.logger.WithError(err).Error("Failed to get sending")
+		return nil, false, false
+	}
+	if snd == nil {
+		return nil, false, true
+	}
+
+	ok, err := a.dao.SetSendingRejected(snd.ID, reason)
+	if err != nil {
+		a.logger.WithError(err).Error("Failed to reject sending")
+		return nil, false, false
+	}
+	if !ok {
+		return snd, false, true
+	}
+
+	a.logger.
+		WithField("service", service).
+		WithField("id", id).
+		WithField("reason", reason).
+		Warnf("Sending is rejected by %v", approver)
+
+	a.wakeup.Kick(wakeup.Notification)
+	snd.Status = types.SendingRejected
+	snd.RejectReason = reason
+	return snd, true, true
+}
+
+// sendingApprovers gets names of administrators approved the sending
+func (a *API) sendingApprovers(sendingID uint64) ([]string, bool) {
+	list, err := a.dao.ListSendingApprovals(sendingID)
+	if err != nil {
+		a.logger.WithError(err).Error("Failed to get sending approvals")
+		return nil, false
+	}
+	names := make([]string, len(list))
+	for i, v := range list {
+		names[i] = v.Approver
+	}
+	return names, true
+}
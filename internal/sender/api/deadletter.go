@@ -0,0 +1,66 @@
+package api
+
+import (
+	"github.com/void616/gm.mint.sender/internal/sender/db/types"
+	"github.com/void616/gm.mint.sender/internal/wakeup"
+)
+
+// ListDeadSendings gets a list of sendings with notification in dead letters (empty service means any service)
+func (a *API) ListDeadSendings(service string, max uint16) ([]*types.Sending, bool) {
+	list, err := a.dao.ListDeadSendings(service, max)
+	if err != nil {
+		a.logger.WithError(err).Error("Failed to get dead sendings")
+		return nil, false
+	}
+	return list, true
+}
+
+// ListDeadApprovements gets a list of approvements with notification in dead letters (empty service means any service)
+func (a *API) ListDeadApprovements(service string, max uint16) ([]*types.Approvement, bool) {
+	list, err := a.dao.ListDeadApprovements(service, max)
+	if err != nil {
+		a.logger.WithError(err).Error("Failed to get dead approvements")
+		return nil, false
+	}
+	return list, true
+}
+
+// RedeliverSending moves sending notification from dead letters back to delivery (found is false if there is no such request, redelivered is false if notification is not in dead letters)
+func (a *API) RedeliverSending(service, id string) (found, redelivered, success bool) {
+	ok, err := a.dao.RedeliverSending(service, id)
+	if err != nil {
+		a.logger.WithError(err).Error("Failed to redeliver sending")
+		return false, false, false
+	}
+	if ok {
+		a.logger.WithField("service", service).WithField("id", id).Info("Sending notification is redelivered")
+		a.wakeup.Kick(wakeup.Notification)
+		return true, true, true
+	}
+	snd, err := a.dao.GetSending(service, id)
+	if err != nil {
+		a.logger.WithError(err).Error("Failed to get sending")
+		return false, false, false
+	}
+	return snd != nil, false, true
+}
+
+// RedeliverApprovement moves approvement notification from dead letters back to delivery (found is false if there is no such request, redelivered is false if notification is not in dead letters)
+func (a *API) RedeliverApprovement(service, id string) (found, redelivered, success bool) {
+	ok, err := a.dao.RedeliverApprovement(service, id)
+	if err != nil {
+		a.logger.WithError(err).Error("Failed to redeliver approvement")
+		return false, false, false
+	}
+	if ok {
+		a.logger.WithField("service", service).WithField("id", id).Info("Approvement notification is redelivered")
+		a.wakeup.Kick(wakeup.Notification)
+		return true, true, true
+	}
+	apv, err := a.dao.GetApprovement(service, id)
+	if err != nil {
+		a.logger.WithError(err).Error("Failed to get approvement")
+		return false, false, false
+	}
+	return apv != nil, false, true
+}
@@ -4,11 +4,12 @@ import (
 	mint "github.com/void616/gm.mint"
 	"github.com/void616/gm.mint.rpc/request"
 	"github.com/void616/gm.mint.sender/internal/sender/db/types"
+	"github.com/void616/gm.mint.sender/internal/wakeup"
 	"github.com/void616/gm.mint/amount"
 )
 
-// EnqueueSending adds a sending to the sender queue
-func (a *API) EnqueueSending(trans types.SendingTransport, id, service, callbackURL string, to mint.PublicKey, amo *amount.Amount, token mint.Token, ignoreApprovement bool) (dup, success bool) {
+// EnqueueSending adds a sending to the sender queue (rejected contains a reason in case the service policy is violated)
+func (a *API) EnqueueSending(trans types.SendingTransport, id, service, callbackURL string, to mint.PublicKey, amo *amount.Amount, token mint.Token, ignoreApprovement bool) (dup bool, rejected string, success bool) {
 	snd := &types.Sending{
 		Transport:         trans,
 		Status:            types.SendingEnqueued,
@@ -21,14 +22,31 @@ func (a *API) EnqueueSending(trans types.SendingTransport, id, service, callback
 		CallbackURL:       callbackURL,
 	}
 
+	// spending policy
+	if a.policies != nil {
+		a.policyLock.Lock()
+		defer a.policyLock.Unlock()
+
+		rejects, err := a.applyPolicy(service, []*types.Sending{snd})
+		if err != nil {
+			a.logger.WithError(err).Error("Failed to apply spending policy")
+			return false, "", false
+		}
+		if rejects[0] != "" {
+			return false, rejects[0], false
+		}
+	}
+	a.requireApproval(snd)
+
 	if err := a.dao.PutSending(snd); err != nil {
 		if a.dao.DuplicateError(err) {
-			return true, false
+			return true, "", false
 		}
 		a.logger.WithError(err).Error("Failed to enqueue sending")
-		return false, false
+		return false, "", false
 	}
-	return false, true
+	a.wakeup.Kick(wakeup.Signing)
+	return false, "", true
 }
 
 // EnqueueApprovement adds an approvement to the sender queue
@@ -77,5 +95,146 @@ func (a *API) EnqueueApprovement(trans types.SendingTransport, id, service, call
 		a.logger.WithError(err).Error("Failed to enqueue approvement")
 		return false, false
 	}
+	a.wakeup.Kick(wakeup.Signing)
 	return false, true
 }
+
+// GetSending gets a sending by service name and request ID (nil means not found)
+func (a *API) GetSending(service, id string) (snd *types.Sending, success bool) {
+	snd, err := a.dao.GetSending(service, id)
+	if err != nil {
+		a.logger.WithError(err).Error("Failed to get sending")
+		return nil, false
+	}
+	return snd, true
+}
+
+// GetApprovement gets an approvement by service name and request ID (nil means not found)
+func (a *API) GetApprovement(service, id string) (apv *types.Approvement, success bool) {
+	apv, err := a.dao.GetApprovement(service, id)
+	if err != nil {
+		a.logger.WithError(err).Error("Failed to get approvement")
+		return nil, false
+	}
+	return apv, true
+}
+
+// CancelSending cancels an enqueued sending (found is false if there is no such request, cancelled is false if request is not enqueued anymore)
+func (a *API) CancelSending(service, id string) (found, cancelled, success bool) {
+	ok, err := a.dao.CancelSending(service, id)
+	if err != nil {
+		a.logger.WithError(err).Error("Failed to cancel sending")
+		return false, false, false
+	}
+	if ok {
+		a.wakeup.Kick(wakeup.Notification)
+		return true, true, true
+	}
+	snd, err := a.dao.GetSending(service, id)
+	if err != nil {
+		a.logger.WithError(err).Error("Failed to get sending")
+		return false, false, false
+	}
+	return snd != nil, false, true
+}
+
+// CancelApprovement cancels an enqueued approvement (found is false if there is no such request, cancelled is false if request is not enqueued anymore)
+func (a *API) CancelApprovement(service, id string) (found, cancelled, success bool) {
+	ok, err := a.dao.CancelApprovement(service, id)
+	if err != nil {
+		a.logger.WithError(err).Error("Failed to cancel approvement")
+		return false, false, false
+	}
+	if ok {
+		a.wakeup.Kick(wakeup.Notification)
+		return true, true, true
+	}
+	apv, err := a.dao.GetApprovement(service, id)
+	if err != nil {
+		a.logger.WithError(err).Error("Failed to get approvement")
+		return false, false, false
+	}
+	return apv != nil, false, true
+}
+
+// EnqueueSendingBatch adds a batch of sendings to the sender queue in one go (dups marks requests skipped as duplicates, rejects contains reasons of requests violating the service policy)
+func (a *API) EnqueueSendingBatch(trans types.SendingTransport, service, callbackURL string, list []*types.Sending) (dups []bool, rejects []string, success bool) {
+	for _, snd := range list {
+		snd.Transport = trans
+		snd.Status = types.SendingEnqueued
+		snd.Service = service
+		snd.CallbackURL = callbackURL
+	}
+
+	dups = make([]bool, len(list))
+	rejects = make([]string, len(list))
+
+	// indices of the sendings to save
+	accepted := make([]int, 0, len(list))
+	for i := range list {
+		accepted = append(accepted, i)
+	}
+
+	// spending policy
+	if a.policies != nil {
+		a.policyLock.Lock()
+		defer a.policyLock.Unlock()
+
+		// duplicates are skipped anyway, so they must not count against the limits
+		fresh := make([]int, 0, len(list))
+		seen := make(map[string]struct{})
+		for i, snd := range list {
+			if _, ok := seen[snd.RequestID]; ok {
+				dups[i] = true
+				continue
+			}
+			seen[snd.RequestID] = struct{}{}
+			v, err := a.dao.GetSending(service, snd.RequestID)
+			if err != nil {
+				a.logger.WithError(err).Error("Failed to get sending")
+				return nil, nil, false
+			}
+			if v != nil {
+				dups[i] = true
+				continue
+			}
+			fresh = append(fresh, i)
+		}
+
+		check := make([]*types.Sending, len(fresh))
+		for j, i := range fresh {
+			check[j] = list[i]
+		}
+		r, err := a.applyPolicy(service, check)
+		if err != nil {
+			a.logger.WithError(err).Error("Failed to apply spending policy")
+			return nil, nil, false
+		}
+		accepted = accepted[:0]
+		for j, i := range fresh {
+			if r[j] != "" {
+				rejects[i] = r[j]
+				continue
+			}
+			accepted = append(accepted, i)
+		}
+	}
+
+	if len(accepted) > 0 {
+		save := make([]*types.Sending, len(accepted))
+		for j, i := range accepted {
+			a.requireApproval(list[i])
+			save[j] = list[i]
+		}
+		d, err := a.dao.PutSendings(save)
+		if err != nil {
+			a.logger.WithError(err).Error("Failed to enqueue sendings batch")
+			return nil, nil, false
+		}
+		a.wakeup.Kick(wakeup.Signing)
+		for j, i := range accepted {
+			dups[i] = d[j]
+		}
+	}
+	return dups, rejects, true
+}
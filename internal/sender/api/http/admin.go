@@ -0,0 +1,270 @@
+package http
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"io/ioutil"
+	gohttp "net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gorilla/mux"
+	"github.com/void616/gm.mint.sender/internal/sender/api/model"
+	pkg "github.com/void616/gm.mint.sender/pkg/sender/http"
+)
+
+// AddAdmins enables administrators endpoints (tokens maps bearer token to administrator name) and should be called before service launch
+func (h *HTTP) AddAdmins(tokens map[string]string) {
+	h.admins = tokens
+}
+
+// authAdmin gets administrator name by the request bearer token or empty string
+func (h *HTTP) authAdmin(r *gohttp.Request) string {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") {
+		return ""
+	}
+	token := []byte(strings.TrimPrefix(auth, "Bearer "))
+
+	name := ""
+	for t, n := range h.admins {
+		if subtle.ConstantTimeCompare([]byte(t), token) == 1 {
+			name = n
+		}
+	}
+	return name
+}
+
+// adminPending is GET method to list sending requests awaiting approval or held
+func (h *HTTP) adminPending(w gohttp.ResponseWriter, r *gohttp.Request) {
+	defer r.Body.Close()
+
+	// metrics
+	if h.metrics != nil {
+		defer func(t time.Time) {
+			h.metrics.RequestDuration.WithLabelValues("admin_pending").Observe(time.Since(t).Seconds())
+		}(time.Now())
+	}
+
+	// reply
+	var res = pkg.PendingResponse{}
+	var status = gohttp.StatusBadRequest
+
+	defer func() {
+		b, err := json.Marshal(&res)
+		if err != nil {
+			h.logger.WithError(err).Error("Failed to marshal response")
+			w.WriteHeader(gohttp.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(status)
+		w.Write(b)
+	}()
+
+	// auth
+	if h.authAdmin(r) == "" {
+		res.Error = "unauthorized"
+		status = gohttp.StatusUnauthorized
+		return
+	}
+
+	// get
+	list, approvers, ok := h.api.ListPendingSendings(model.MaxPendingItems)
+	if !ok {
+		res.Error = "internal failure"
+		status = gohttp.StatusInternalServerError
+		return
+	}
+
+	// success
+	res.Success = true
+	res.Items = make([]pkg.PendingItem, len(list))
+	for i, snd := range list {
+		res.Items[i] = pkg.PendingItem{
+			Status:    snd.Status.String(),
+			Service:   snd.Service,
+			ID:        snd.RequestID,
+			PublicKey: snd.To.String(),
+			Token:     snd.Token.String(),
+			Amount:    snd.Amount.String(),
+			Approvers: approvers[i],
+		}
+	}
+	status = gohttp.StatusOK
+}
+
+// adminApprove is POST method to approve pending sending request on behalf of the administrator
+func (h *HTTP) adminApprove(w gohttp.ResponseWriter, r *gohttp.Request) {
+	defer r.Body.Close()
+
+	// metrics
+	if h.metrics != nil {
+		defer func(t time.Time) {
+			h.metrics.RequestDuration.WithLabelValues("admin_approve").Observe(time.Since(t).Seconds())
+		}(time.Now())
+	}
+
+	vars := mux.Vars(r)
+	reqService, reqID := vars["service"], vars["id"]
+
+	h.logger.WithField("data", reqService+":"+reqID).Debug("Got sending approval")
+
+	// reply
+	var res = pkg.AdminApproveResponse{}
+	var status = gohttp.StatusBadRequest
+
+	defer func() {
+		b, err := json.Marshal(&res)
+		if err != nil {
+			h.logger.WithError(err).Error("Failed to marshal response")
+			w.WriteHeader(gohttp.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(status)
+		w.Write(b)
+	}()
+
+	// auth
+	admin := h.authAdmin(r)
+	if admin == "" {
+		res.Error = "unauthorized"
+		status = gohttp.StatusUnauthorized
+		return
+	}
+
+	// check req service
+	if !model.ServiceNameRex.MatchString(reqService) {
+		res.Error = "invalid service name"
+		return
+	}
+
+	// check req id
+	if !model.RequestIDRex.MatchString(reqID) {
+		res.Error = "invalid request ID"
+		return
+	}
+
+	// approve
+	snd, approvers, accepted, ok := h.api.ApproveSending(reqService, reqID, admin)
+	switch {
+	case !ok:
+		res.Error = "internal failure"
+		status = gohttp.StatusInternalServerError
+		return
+	case snd == nil:
+		res.Error = "request not found"
+		status = gohttp.StatusNotFound
+		return
+	case !accepted:
+		res.Error = "request is not pending anymore"
+		res.Status = snd.Status.String()
+		status = gohttp.StatusConflict
+		return
+	}
+
+	// success
+	res.Success = true
+	res.Status = snd.Status.String()
+	res.Approvers = approvers
+	status = gohttp.StatusOK
+}
+
+// adminReject is POST method to reject pending sending request on behalf of the administrator
+func (h *HTTP) adminReject(w gohttp.ResponseWriter, r *gohttp.Request) {
+	defer r.Body.Close()
+
+	// metrics
+	if h.metrics != nil {
+		defer func(t time.Time) {
+			h.metrics.RequestDuration.WithLabelValues("admin_reject").Observe(time.Since(t).Seconds())
+		}(time.Now())
+	}
+
+	vars := mux.Vars(r)
+	reqService, reqID := vars["service"], vars["id"]
+
+	h.logger.WithField("data", reqService+":"+reqID).Debug("Got sending rejection")
+
+	// reply
+	var res = struct {
+		Success bool   `json:"success"`
+		Error   string `json:"error,omitempty"`
+		Status  int    `json:"-"`
+	}{false, "", gohttp.StatusBadRequest}
+
+	defer func() {
+		b, err := json.Marshal(&res)
+		if err != nil {
+			h.logger.WithError(err).Error("Failed to marshal response")
+			w.WriteHeader(gohttp.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(res.Status)
+		w.Write(b)
+	}()
+
+	// auth
+	admin := h.authAdmin(r)
+	if admin == "" {
+		res.Error = "unauthorized"
+		res.Status = gohttp.StatusUnauthorized
+		return
+	}
+
+	// parse
+	req := pkg.AdminRejectRequest{}
+	{
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			h.logger.WithError(err).Error("Failed to read request")
+			return
+		}
+		if len(b) > 0 {
+			if err := json.Unmarshal(b, &req); err != nil {
+				res.Error = "invalid request"
+				return
+			}
+		}
+	}
+
+	// check req service
+	if !model.ServiceNameRex.MatchString(reqService) {
+		res.Error = "invalid service name"
+		return
+	}
+
+	// check req id
+	if !model.RequestIDRex.MatchString(reqID) {
+		res.Error = "invalid request ID"
+		return
+	}
+
+	// check reason
+	if utf8.RuneCountInString(req.Reason) > 256 {
+		res.Error = "invalid reason"
+		return
+	}
+
+	// reject
+	snd, rejected, ok := h.api.RejectSending(reqService, reqID, admin, req.Reason)
+	switch {
+	case !ok:
+		res.Error = "internal failure"
+		res.Status = gohttp.StatusInternalServerError
+		return
+	case snd == nil:
+		res.Error = "request not found"
+		res.Status = gohttp.StatusNotFound
+		return
+	case !rejected:
+		res.Error = "request is not pending anymore"
+		res.Status = gohttp.StatusConflict
+		return
+	}
+
+	// success
+	res.Success = true
+	res.Error = ""
+	res.Status = gohttp.StatusOK
+}
@@ -0,0 +1,29 @@
+package http
+
+import (
+	gohttp "net/http"
+
+	"github.com/void616/gm.mint.sender/internal/auth"
+)
+
+// AddAuth enables authentication of requests and signing of callbacks and should be called before service launch
+func (h *HTTP) AddAuth(k *auth.Keys) {
+	h.auth = k
+}
+
+// AddLowBalanceSecret enables signing of low balance callbacks (they aren't related to any service) and should be called before service launch
+func (h *HTTP) AddLowBalanceSecret(secret string) {
+	h.lbKey = []byte(secret)
+}
+
+// authenticate checks signature of the service request in case authentication is enabled
+func (h *HTTP) authenticate(r *gohttp.Request, service string, body []byte) bool {
+	if h.auth == nil {
+		return true
+	}
+	if err := h.auth.VerifyHTTP(r, service, body); err != nil {
+		h.logger.WithError(err).WithField("service", service).Warn("Request authentication failed")
+		return false
+	}
+	return true
+}
@@ -0,0 +1,188 @@
+package http
+
+import (
+	"encoding/json"
+	gohttp "net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/void616/gm.mint.sender/internal/sender/api/model"
+	pkg "github.com/void616/gm.mint.sender/pkg/sender/http"
+)
+
+// adminDead is GET method to list requests with notification in dead letters (optional service query parameter)
+func (h *HTTP) adminDead(w gohttp.ResponseWriter, r *gohttp.Request) {
+	defer r.Body.Close()
+
+	// metrics
+	if h.metrics != nil {
+		defer func(t time.Time) {
+			h.metrics.RequestDuration.WithLabelValues("admin_dead").Observe(time.Since(t).Seconds())
+		}(time.Now())
+	}
+
+	// reply
+	var res = pkg.DeadResponse{}
+	var status = gohttp.StatusBadRequest
+
+	defer func() {
+		b, err := json.Marshal(&res)
+		if err != nil {
+			h.logger.WithError(err).Error("Failed to marshal response")
+			w.WriteHeader(gohttp.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(status)
+		w.Write(b)
+	}()
+
+	// auth
+	if h.authAdmin(r) == "" {
+		res.Error = "unauthorized"
+		status = gohttp.StatusUnauthorized
+		return
+	}
+
+	// check req service
+	reqService := r.URL.Query().Get("service")
+	if reqService != "" && !model.ServiceNameRex.MatchString(reqService) {
+		res.Error = "invalid service name"
+		return
+	}
+
+	// get
+	sendings, ok := h.api.ListDeadSendings(reqService, model.MaxDeadItems)
+	if !ok {
+		res.Error = "internal failure"
+		status = gohttp.StatusInternalServerError
+		return
+	}
+	approvements, ok := h.api.ListDeadApprovements(reqService, model.MaxDeadItems)
+	if !ok {
+		res.Error = "internal failure"
+		status = gohttp.StatusInternalServerError
+		return
+	}
+
+	// success
+	res.Success = true
+	res.Sendings = make([]pkg.DeadItem, len(sendings))
+	for i, snd := range sendings {
+		res.Sendings[i] = pkg.DeadItem{
+			Service:       snd.Service,
+			ID:            snd.RequestID,
+			Status:        snd.Status.String(),
+			Attempts:      snd.NotifyAttempts,
+			FirstNotifyAt: formatTime(snd.FirstNotifyAt),
+		}
+	}
+	res.Approvements = make([]pkg.DeadItem, len(approvements))
+	for i, apv := range approvements {
+		res.Approvements[i] = pkg.DeadItem{
+			Service:       apv.Service,
+			ID:            apv.RequestID,
+			Status:        apv.Status.String(),
+			Attempts:      apv.NotifyAttempts,
+			FirstNotifyAt: formatTime(apv.FirstNotifyAt),
+		}
+	}
+	status = gohttp.StatusOK
+}
+
+// adminRedeliverSending is POST method to redeliver sending notification from dead letters
+func (h *HTTP) adminRedeliverSending(w gohttp.ResponseWriter, r *gohttp.Request) {
+	h.adminRedeliver(w, r, false)
+}
+
+// adminRedeliverApprovement is POST method to redeliver approvement notification from dead letters
+func (h *HTTP) adminRedeliverApprovement(w gohttp.ResponseWriter, r *gohttp.Request) {
+	h.adminRedeliver(w, r, true)
+}
+
+// adminRedeliver moves sending or approvement notification from dead letters back to delivery
+func (h *HTTP) adminRedeliver(w gohttp.ResponseWriter, r *gohttp.Request, approvement bool) {
+	defer r.Body.Close()
+
+	// metrics
+	if h.metrics != nil {
+		defer func(t time.Time) {
+			h.metrics.RequestDuration.WithLabelValues("admin_redeliver").Observe(time.Since(t).Seconds())
+		}(time.Now())
+	}
+
+	vars := mux.Vars(r)
+	reqService, reqID := vars["service"], vars["id"]
+
+	h.logger.WithField("data", reqService+":"+reqID).Debug("Got redelivery request")
+
+	// reply
+	var res = struct {
+		Success bool   `json:"success"`
+		Error   string `json:"error,omitempty"`
+		Status  int    `json:"-"`
+	}{false, "", gohttp.StatusBadRequest}
+
+	defer func() {
+		b, err := json.Marshal(&res)
+		if err != nil {
+			h.logger.WithError(err).Error("Failed to marshal response")
+			w.WriteHeader(gohttp.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(res.Status)
+		w.Write(b)
+	}()
+
+	// auth
+	if h.authAdmin(r) == "" {
+		res.Error = "unauthorized"
+		res.Status = gohttp.StatusUnauthorized
+		return
+	}
+
+	// check req service
+	if !model.ServiceNameRex.MatchString(reqService) {
+		res.Error = "invalid service name"
+		return
+	}
+
+	// check req id
+	if !model.RequestIDRex.MatchString(reqID) {
+		res.Error = "invalid request ID"
+		return
+	}
+
+	// redeliver
+	var found, redelivered, ok bool
+	if approvement {
+		found, redelivered, ok = h.api.RedeliverApprovement(reqService, reqID)
+	} else {
+		found, redelivered, ok = h.api.RedeliverSending(reqService, reqID)
+	}
+	switch {
+	case !ok:
+		res.Error = "internal failure"
+		res.Status = gohttp.StatusInternalServerError
+		return
+	case !found:
+		res.Error = "request not found"
+		res.Status = gohttp.StatusNotFound
+		return
+	case !redelivered:
+		res.Error = "notification is not in dead letters"
+		res.Status = gohttp.StatusConflict
+		return
+	}
+
+	// success
+	res.Success = true
+	res.Status = gohttp.StatusOK
+}
+
+// formatTime formats optional time as RFC3339 or empty string
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -7,6 +7,7 @@ import (
 	gohttp "net/http"
 	"time"
 
+	"github.com/gorilla/mux"
 	mint "github.com/void616/gm.mint"
 	"github.com/void616/gm.mint.sender/internal/sender/api/model"
 	"github.com/void616/gm.mint.sender/internal/sender/db/types"
@@ -27,6 +28,7 @@ func (h *HTTP) send(w gohttp.ResponseWriter, r *gohttp.Request) {
 
 	// parse
 	req := pkg.SendRequest{}
+	var body []byte
 	{
 		b, err := ioutil.ReadAll(r.Body)
 		if err != nil {
@@ -37,6 +39,7 @@ func (h *HTTP) send(w gohttp.ResponseWriter, r *gohttp.Request) {
 			h.logger.WithError(err).Error("Failed to unmarshal request")
 			return
 		}
+		body = b
 	}
 
 	h.logger.WithField("data", req.String()).Debug("Got sending request")
@@ -65,6 +68,13 @@ func (h *HTTP) send(w gohttp.ResponseWriter, r *gohttp.Request) {
 		return
 	}
 
+	// authenticate
+	if !h.authenticate(r, req.Service, body) {
+		res.Error = "unauthorized"
+		res.Status = gohttp.StatusUnauthorized
+		return
+	}
+
 	// check req id
 	if !model.RequestIDRex.MatchString(req.ID) {
 		res.Error = "invalid request ID"
@@ -101,9 +111,12 @@ func (h *HTTP) send(w gohttp.ResponseWriter, r *gohttp.Request) {
 	}
 
 	// enqueue
-	if dups, ok := h.api.EnqueueSending(types.SendingHTTP, req.ID, req.Service, req.Callback, reqAddr, reqAmount, reqToken, req.IgnoreApprovement); !ok {
+	if dups, rejected, ok := h.api.EnqueueSending(types.SendingHTTP, req.ID, req.Service, req.Callback, reqAddr, reqAmount, reqToken, req.IgnoreApprovement); !ok {
 		if dups {
 			res.Error = "request with the same ID registered"
+		} else if rejected != "" {
+			res.Error = rejected
+			res.Status = gohttp.StatusForbidden
 		} else {
 			res.Error = "internal failure"
 			res.Status = gohttp.StatusInternalServerError
@@ -117,6 +130,153 @@ func (h *HTTP) send(w gohttp.ResponseWriter, r *gohttp.Request) {
 	res.Status = gohttp.StatusOK
 }
 
+// sendBatch is POST method to request token sending to multiple wallets at once
+func (h *HTTP) sendBatch(w gohttp.ResponseWriter, r *gohttp.Request) {
+	defer r.Body.Close()
+
+	// metrics
+	if h.metrics != nil {
+		defer func(t time.Time) {
+			h.metrics.RequestDuration.WithLabelValues("send_batch").Observe(time.Since(t).Seconds())
+		}(time.Now())
+	}
+
+	// parse
+	req := pkg.SendBatchRequest{}
+	var body []byte
+	{
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			h.logger.WithError(err).Error("Failed to read request")
+			return
+		}
+		if err := json.Unmarshal(b, &req); err != nil {
+			h.logger.WithError(err).Error("Failed to unmarshal request")
+			return
+		}
+		body = b
+	}
+
+	h.logger.WithField("data", req.String()).Debug("Got sending batch request")
+
+	// reply
+	var res = pkg.SendBatchResponse{}
+	var status = gohttp.StatusBadRequest
+
+	defer func() {
+		b, err := json.Marshal(&res)
+		if err != nil {
+			h.logger.WithError(err).Error("Failed to marshal response")
+			w.WriteHeader(gohttp.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(status)
+		w.Write(b)
+	}()
+
+	// check req service
+	if !model.ServiceNameRex.MatchString(req.Service) {
+		res.Error = "invalid service name"
+		return
+	}
+
+	// authenticate
+	if !h.authenticate(r, req.Service, body) {
+		res.Error = "unauthorized"
+		status = gohttp.StatusUnauthorized
+		return
+	}
+
+	// parse callback
+	if req.Callback != "" {
+		if !model.ValidCallback(req.Callback) {
+			res.Error = "invalid callback"
+			return
+		}
+	}
+
+	// check items count
+	if len(req.Items) == 0 || len(req.Items) > model.MaxBatchItems {
+		res.Error = "invalid number of items"
+		return
+	}
+
+	// validate items
+	results := make([]pkg.SendBatchResult, len(req.Items))
+	list := make([]*types.Sending, 0, len(req.Items))
+	index := make([]int, 0, len(req.Items))
+	for i, v := range req.Items {
+		results[i] = pkg.SendBatchResult{ID: v.ID, Result: model.BatchItemInvalid}
+
+		// check req id
+		if !model.RequestIDRex.MatchString(v.ID) {
+			results[i].Error = "invalid request ID"
+			continue
+		}
+
+		// parse wallet address
+		reqAddr, err := mint.ParsePublicKey(v.PublicKey)
+		if err != nil {
+			results[i].Error = "invalid public key"
+			continue
+		}
+
+		// parse token
+		reqToken, err := mint.ParseToken(v.Token)
+		if err != nil {
+			results[i].Error = "invalid token"
+			continue
+		}
+
+		// valid amount
+		reqAmount, err := amount.FromString(v.Amount)
+		if err != nil || reqAmount.Value.Cmp(new(big.Int)) <= 0 {
+			results[i].Error = "invalid amount"
+			continue
+		}
+
+		list = append(list, &types.Sending{
+			RequestID:         v.ID,
+			To:                reqAddr,
+			Token:             reqToken,
+			Amount:            reqAmount,
+			IgnoreApprovement: v.IgnoreApprovement,
+		})
+		index = append(index, i)
+	}
+
+	// enqueue
+	if len(list) > 0 {
+		dups, rejects, ok := h.api.EnqueueSendingBatch(types.SendingHTTP, req.Service, req.Callback, list)
+		if !ok {
+			res.Error = "internal failure"
+			status = gohttp.StatusInternalServerError
+			return
+		}
+		for i, dup := range dups {
+			switch {
+			case rejects[i] != "":
+				results[index[i]].Result = model.BatchItemRejected
+				results[index[i]].Error = rejects[i]
+			case dup:
+				results[index[i]].Result = model.BatchItemDuplicate
+				results[index[i]].Error = "request with the same ID registered"
+			case list[i].Status == types.SendingHeld:
+				results[index[i]].Result = model.BatchItemHeld
+			case list[i].Status == types.SendingAwaitingApproval:
+				results[index[i]].Result = model.BatchItemAwaiting
+			default:
+				results[index[i]].Result = model.BatchItemAccepted
+			}
+		}
+	}
+
+	// success
+	res.Success = true
+	res.Items = results
+	status = gohttp.StatusOK
+}
+
 // approve is POST method to request wallet approvement
 func (h *HTTP) approve(w gohttp.ResponseWriter, r *gohttp.Request) {
 	defer r.Body.Close()
@@ -130,6 +290,7 @@ func (h *HTTP) approve(w gohttp.ResponseWriter, r *gohttp.Request) {
 
 	// parse
 	req := pkg.ApproveRequest{}
+	var body []byte
 	{
 		b, err := ioutil.ReadAll(r.Body)
 		if err != nil {
@@ -140,6 +301,7 @@ func (h *HTTP) approve(w gohttp.ResponseWriter, r *gohttp.Request) {
 			h.logger.WithError(err).Error("Failed to unmarshal request")
 			return
 		}
+		body = b
 	}
 
 	h.logger.WithField("data", req.String()).Debug("Got approvement request")
@@ -168,6 +330,13 @@ func (h *HTTP) approve(w gohttp.ResponseWriter, r *gohttp.Request) {
 		return
 	}
 
+	// authenticate
+	if !h.authenticate(r, req.Service, body) {
+		res.Error = "unauthorized"
+		res.Status = gohttp.StatusUnauthorized
+		return
+	}
+
 	// check req id
 	if !model.RequestIDRex.MatchString(req.ID) {
 		res.Error = "invalid request ID"
@@ -205,3 +374,337 @@ func (h *HTTP) approve(w gohttp.ResponseWriter, r *gohttp.Request) {
 	res.Error = ""
 	res.Status = gohttp.StatusOK
 }
+
+// sendStatus is GET method to get token sending request status
+func (h *HTTP) sendStatus(w gohttp.ResponseWriter, r *gohttp.Request) {
+	defer r.Body.Close()
+
+	// metrics
+	if h.metrics != nil {
+		defer func(t time.Time) {
+			h.metrics.RequestDuration.WithLabelValues("send_status").Observe(time.Since(t).Seconds())
+		}(time.Now())
+	}
+
+	vars := mux.Vars(r)
+	reqService, reqID := vars["service"], vars["id"]
+
+	h.logger.WithField("data", reqService+":"+reqID).Debug("Got sending status request")
+
+	// reply
+	var res = pkg.SendStatusResponse{}
+	var status = gohttp.StatusBadRequest
+
+	defer func() {
+		b, err := json.Marshal(&res)
+		if err != nil {
+			h.logger.WithError(err).Error("Failed to marshal response")
+			w.WriteHeader(gohttp.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(status)
+		w.Write(b)
+	}()
+
+	// check req service
+	if !model.ServiceNameRex.MatchString(reqService) {
+		res.Error = "invalid service name"
+		return
+	}
+
+	// authenticate
+	if !h.authenticate(r, reqService, nil) {
+		res.Error = "unauthorized"
+		status = gohttp.StatusUnauthorized
+		return
+	}
+
+	// check req id
+	if !model.RequestIDRex.MatchString(reqID) {
+		res.Error = "invalid request ID"
+		return
+	}
+
+	// get
+	snd, ok := h.api.GetSending(reqService, reqID)
+	if !ok {
+		res.Error = "internal failure"
+		status = gohttp.StatusInternalServerError
+		return
+	}
+	if snd == nil {
+		res.Error = "request not found"
+		status = gohttp.StatusNotFound
+		return
+	}
+
+	// success
+	res = pkg.SendStatusResponse{
+		Success:   true,
+		Status:    snd.Status.String(),
+		Service:   snd.Service,
+		ID:        snd.RequestID,
+		PublicKey: snd.To.String(),
+		Token:     snd.Token.String(),
+		Amount:    snd.Amount.String(),
+		Notified:  snd.Notified,
+	}
+	if snd.Sender != nil {
+		res.Sender = (*snd.Sender).String()
+	}
+	if snd.SenderNonce != nil {
+		res.Nonce = *snd.SenderNonce
+	}
+	if snd.Digest != nil {
+		res.Transaction = (*snd.Digest).String()
+	}
+	if snd.SentAtBlock != nil {
+		res.SentAtBlock = snd.SentAtBlock.String()
+	}
+	if snd.Block != nil {
+		res.Block = snd.Block.String()
+	}
+	status = gohttp.StatusOK
+}
+
+// approveStatus is GET method to get wallet approvement request status
+func (h *HTTP) approveStatus(w gohttp.ResponseWriter, r *gohttp.Request) {
+	defer r.Body.Close()
+
+	// metrics
+	if h.metrics != nil {
+		defer func(t time.Time) {
+			h.metrics.RequestDuration.WithLabelValues("approve_status").Observe(time.Since(t).Seconds())
+		}(time.Now())
+	}
+
+	vars := mux.Vars(r)
+	reqService, reqID := vars["service"], vars["id"]
+
+	h.logger.WithField("data", reqService+":"+reqID).Debug("Got approvement status request")
+
+	// reply
+	var res = pkg.ApproveStatusResponse{}
+	var status = gohttp.StatusBadRequest
+
+	defer func() {
+		b, err := json.Marshal(&res)
+		if err != nil {
+			h.logger.WithError(err).Error("Failed to marshal response")
+			w.WriteHeader(gohttp.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(status)
+		w.Write(b)
+	}()
+
+	// check req service
+	if !model.ServiceNameRex.MatchString(reqService) {
+		res.Error = "invalid service name"
+		return
+	}
+
+	// authenticate
+	if !h.authenticate(r, reqService, nil) {
+		res.Error = "unauthorized"
+		status = gohttp.StatusUnauthorized
+		return
+	}
+
+	// check req id
+	if !model.RequestIDRex.MatchString(reqID) {
+		res.Error = "invalid request ID"
+		return
+	}
+
+	// get
+	apv, ok := h.api.GetApprovement(reqService, reqID)
+	if !ok {
+		res.Error = "internal failure"
+		status = gohttp.StatusInternalServerError
+		return
+	}
+	if apv == nil {
+		res.Error = "request not found"
+		status = gohttp.StatusNotFound
+		return
+	}
+
+	// success
+	res = pkg.ApproveStatusResponse{
+		Success:   true,
+		Status:    apv.Status.String(),
+		Service:   apv.Service,
+		ID:        apv.RequestID,
+		PublicKey: apv.To.String(),
+		Notified:  apv.Notified,
+	}
+	if apv.Sender != nil {
+		res.Sender = (*apv.Sender).String()
+	}
+	if apv.SenderNonce != nil {
+		res.Nonce = *apv.SenderNonce
+	}
+	if apv.Digest != nil {
+		res.Transaction = (*apv.Digest).String()
+	}
+	if apv.SentAtBlock != nil {
+		res.SentAtBlock = apv.SentAtBlock.String()
+	}
+	if apv.Block != nil {
+		res.Block = apv.Block.String()
+	}
+	status = gohttp.StatusOK
+}
+
+// sendCancel is DELETE method to cancel enqueued token sending request
+func (h *HTTP) sendCancel(w gohttp.ResponseWriter, r *gohttp.Request) {
+	defer r.Body.Close()
+
+	// metrics
+	if h.metrics != nil {
+		defer func(t time.Time) {
+			h.metrics.RequestDuration.WithLabelValues("send_cancel").Observe(time.Since(t).Seconds())
+		}(time.Now())
+	}
+
+	vars := mux.Vars(r)
+	reqService, reqID := vars["service"], vars["id"]
+
+	h.logger.WithField("data", reqService+":"+reqID).Debug("Got sending cancellation request")
+
+	// reply
+	var res = struct {
+		Success bool   `json:"success"`
+		Error   string `json:"error,omitempty"`
+		Status  int    `json:"-"`
+	}{false, "", gohttp.StatusBadRequest}
+
+	defer func() {
+		b, err := json.Marshal(&res)
+		if err != nil {
+			h.logger.WithError(err).Error("Failed to marshal response")
+			w.WriteHeader(gohttp.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(res.Status)
+		w.Write(b)
+	}()
+
+	// check req service
+	if !model.ServiceNameRex.MatchString(reqService) {
+		res.Error = "invalid service name"
+		return
+	}
+
+	// authenticate
+	if !h.authenticate(r, reqService, nil) {
+		res.Error = "unauthorized"
+		res.Status = gohttp.StatusUnauthorized
+		return
+	}
+
+	// check req id
+	if !model.RequestIDRex.MatchString(reqID) {
+		res.Error = "invalid request ID"
+		return
+	}
+
+	// cancel
+	found, cancelled, ok := h.api.CancelSending(reqService, reqID)
+	switch {
+	case !ok:
+		res.Error = "internal failure"
+		res.Status = gohttp.StatusInternalServerError
+		return
+	case !found:
+		res.Error = "request not found"
+		res.Status = gohttp.StatusNotFound
+		return
+	case !cancelled:
+		res.Error = "request is not enqueued anymore"
+		res.Status = gohttp.StatusConflict
+		return
+	}
+
+	// success
+	res.Success = true
+	res.Error = ""
+	res.Status = gohttp.StatusOK
+}
+
+// approveCancel is DELETE method to cancel enqueued wallet approvement request
+func (h *HTTP) approveCancel(w gohttp.ResponseWriter, r *gohttp.Request) {
+	defer r.Body.Close()
+
+	// metrics
+	if h.metrics != nil {
+		defer func(t time.Time) {
+			h.metrics.RequestDuration.WithLabelValues("approve_cancel").Observe(time.Since(t).Seconds())
+		}(time.Now())
+	}
+
+	vars := mux.Vars(r)
+	reqService, reqID := vars["service"], vars["id"]
+
+	h.logger.WithField("data", reqService+":"+reqID).Debug("Got approvement cancellation request")
+
+	// reply
+	var res = struct {
+		Success bool   `json:"success"`
+		Error   string `json:"error,omitempty"`
+		Status  int    `json:"-"`
+	}{false, "", gohttp.StatusBadRequest}
+
+	defer func() {
+		b, err := json.Marshal(&res)
+		if err != nil {
+			h.logger.WithError(err).Error("Failed to marshal response")
+			w.WriteHeader(gohttp.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(res.Status)
+		w.Write(b)
+	}()
+
+	// check req service
+	if !model.ServiceNameRex.MatchString(reqService) {
+		res.Error = "invalid service name"
+		return
+	}
+
+	// authenticate
+	if !h.authenticate(r, reqService, nil) {
+		res.Error = "unauthorized"
+		res.Status = gohttp.StatusUnauthorized
+		return
+	}
+
+	// check req id
+	if !model.RequestIDRex.MatchString(reqID) {
+		res.Error = "invalid request ID"
+		return
+	}
+
+	// cancel
+	found, cancelled, ok := h.api.CancelApprovement(reqService, reqID)
+	switch {
+	case !ok:
+		res.Error = "internal failure"
+		res.Status = gohttp.StatusInternalServerError
+		return
+	case !found:
+		res.Error = "request not found"
+		res.Status = gohttp.StatusNotFound
+		return
+	case !cancelled:
+		res.Error = "request is not enqueued anymore"
+		res.Status = gohttp.StatusConflict
+		return
+	}
+
+	// success
+	res.Success = true
+	res.Error = ""
+	res.Status = gohttp.StatusOK
+}
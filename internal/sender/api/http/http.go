@@ -1,6 +1,7 @@
 package http
 
 import (
+	"crypto/tls"
 	"fmt"
 	gohttp "net/http"
 	"sync"
@@ -10,6 +11,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/auth"
 	"github.com/void616/gm.mint.sender/internal/sender/db/types"
 	"github.com/void616/gm.mint/amount"
 	"github.com/void616/gotask"
@@ -21,12 +23,27 @@ type HTTP struct {
 	api     API
 	server  *gohttp.Server
 	metrics *Metrics
+	auth    *auth.Keys
+	admins  map[string]string
+	lbKey   []byte
 }
 
 // API provides ability to interact with service API
 type API interface {
-	EnqueueSending(trans types.SendingTransport, id, service, callbackURL string, to mint.PublicKey, amo *amount.Amount, token mint.Token, ignoreApprovement bool) (dup, success bool)
+	EnqueueSending(trans types.SendingTransport, id, service, callbackURL string, to mint.PublicKey, amo *amount.Amount, token mint.Token, ignoreApprovement bool) (dup bool, rejected string, success bool)
+	EnqueueSendingBatch(trans types.SendingTransport, service, callbackURL string, list []*types.Sending) (dups []bool, rejects []string, success bool)
 	EnqueueApprovement(trans types.SendingTransport, id, service, callbackURL string, to mint.PublicKey) (dup, success bool)
+	GetSending(service, id string) (*types.Sending, bool)
+	GetApprovement(service, id string) (*types.Approvement, bool)
+	CancelSending(service, id string) (found, cancelled, success bool)
+	CancelApprovement(service, id string) (found, cancelled, success bool)
+	ListPendingSendings(max uint16) (list []*types.Sending, approvers [][]string, success bool)
+	ApproveSending(service, id, approver string) (snd *types.Sending, approvers []string, accepted, success bool)
+	RejectSending(service, id, approver, reason string) (snd *types.Sending, rejected, success bool)
+	ListDeadSendings(service string, max uint16) ([]*types.Sending, bool)
+	ListDeadApprovements(service string, max uint16) ([]*types.Approvement, bool)
+	RedeliverSending(service, id string) (found, redelivered, success bool)
+	RedeliverApprovement(service, id string) (found, redelivered, success bool)
 }
 
 // New instance
@@ -53,7 +70,18 @@ func New(
 	}
 
 	r.Path("/send").Methods("POST").HandlerFunc(h.send)
+	r.Path("/send/batch").Methods("POST").HandlerFunc(h.sendBatch)
 	r.Path("/approve").Methods("POST").HandlerFunc(h.approve)
+	r.Path("/send/{service}/{id}").Methods("GET").HandlerFunc(h.sendStatus)
+	r.Path("/approve/{service}/{id}").Methods("GET").HandlerFunc(h.approveStatus)
+	r.Path("/send/{service}/{id}").Methods("DELETE").HandlerFunc(h.sendCancel)
+	r.Path("/approve/{service}/{id}").Methods("DELETE").HandlerFunc(h.approveCancel)
+	r.Path("/admin/send").Methods("GET").HandlerFunc(h.adminPending)
+	r.Path("/admin/send/{service}/{id}/approve").Methods("POST").HandlerFunc(h.adminApprove)
+	r.Path("/admin/send/{service}/{id}/reject").Methods("POST").HandlerFunc(h.adminReject)
+	r.Path("/admin/dead").Methods("GET").HandlerFunc(h.adminDead)
+	r.Path("/admin/send/{service}/{id}/redeliver").Methods("POST").HandlerFunc(h.adminRedeliverSending)
+	r.Path("/admin/approve/{service}/{id}/redeliver").Methods("POST").HandlerFunc(h.adminRedeliverApprovement)
 
 	logger.Infof("HTTP transport enabled on port %v", port)
 	return h, nil
@@ -70,6 +98,11 @@ func (h *HTTP) AddMetrics(m *Metrics) {
 	h.metrics = m
 }
 
+// AddTLS serves HTTPS with the config (see secure.ServerTLS) and should be called before service launch
+func (h *HTTP) AddTLS(c *tls.Config) {
+	h.server.TLSConfig = c
+}
+
 // Task loop
 func (h *HTTP) Task(token *gotask.Token) {
 
@@ -79,7 +112,13 @@ func (h *HTTP) Task(token *gotask.Token) {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		if err := h.server.ListenAndServe(); err != nil && err != gohttp.ErrServerClosed {
+		var err error
+		if h.server.TLSConfig != nil {
+			err = h.server.ListenAndServeTLS("", "")
+		} else {
+			err = h.server.ListenAndServe()
+		}
+		if err != nil && err != gohttp.ErrServerClosed {
 			h.logger.WithError(err).Error("Failed to listen and serve")
 			token.Stop()
 		}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/auth"
 	pkg "github.com/void616/gm.mint.sender/pkg/sender/http"
 	"github.com/void616/gm.mint/amount"
 )
@@ -21,6 +22,7 @@ func (h *HTTP) PublishSentEvent(
 	token mint.Token,
 	amo *amount.Amount,
 	digest *mint.Digest,
+	confirmations uint64,
 ) error {
 	// metrics
 	if h.metrics != nil {
@@ -35,48 +37,22 @@ func (h *HTTP) PublishSentEvent(
 	}
 
 	event := pkg.SentEvent{
-		Success:     success,
-		Error:       msgerr,
-		Service:     service,
-		ID:          requestID,
-		PublicKey:   to.String(),
-		Token:       token.String(),
-		Amount:      amo.String(),
-		Transaction: transaction,
+		Success:       success,
+		Error:         msgerr,
+		Service:       service,
+		ID:            requestID,
+		PublicKey:     to.String(),
+		Token:         token.String(),
+		Amount:        amo.String(),
+		Transaction:   transaction,
+		Confirmations: confirmations,
 	}
 
 	b, err := json.Marshal(&event)
 	if err != nil {
 		return err
 	}
-
-	// http request
-	{
-		timeoutSec := 10
-		transport := &http.Transport{
-			IdleConnTimeout: time.Second * time.Duration(timeoutSec),
-		}
-		client := &http.Client{
-			Timeout:   time.Second * time.Duration(timeoutSec),
-			Transport: transport,
-		}
-		req, err := http.NewRequest("POST", callbackURL, bytes.NewBuffer(b))
-		if err != nil {
-			return err
-		}
-		req.Header.Set("Content-Type", "application/json; charset=utf-8")
-		resp, err := client.Do(req)
-		if err != nil {
-			return err
-		}
-		defer resp.Body.Close()
-
-		if resp.StatusCode != 200 {
-			return fmt.Errorf("callback status code is %v", resp.StatusCode)
-		}
-	}
-
-	return nil
+	return h.postCallback(callbackURL, service, b)
 }
 
 // PublishApprovedEvent sends an approvement completion notification
@@ -86,6 +62,7 @@ func (h *HTTP) PublishApprovedEvent(
 	service, requestID, callbackURL string,
 	to mint.PublicKey,
 	digest *mint.Digest,
+	confirmations uint64,
 ) error {
 	// metrics
 	if h.metrics != nil {
@@ -100,44 +77,82 @@ func (h *HTTP) PublishApprovedEvent(
 	}
 
 	event := pkg.ApprovedEvent{
-		Success:     success,
-		Error:       msgerr,
-		Service:     service,
-		ID:          requestID,
-		PublicKey:   to.String(),
-		Transaction: transaction,
+		Success:       success,
+		Error:         msgerr,
+		Service:       service,
+		ID:            requestID,
+		PublicKey:     to.String(),
+		Transaction:   transaction,
+		Confirmations: confirmations,
 	}
 
 	b, err := json.Marshal(&event)
 	if err != nil {
 		return err
 	}
+	return h.postCallback(callbackURL, service, b)
+}
 
-	// http request
-	{
-		timeoutSec := 10
-		transport := &http.Transport{
-			IdleConnTimeout: time.Second * time.Duration(timeoutSec),
-		}
-		client := &http.Client{
-			Timeout:   time.Second * time.Duration(timeoutSec),
-			Transport: transport,
-		}
-		req, err := http.NewRequest("POST", callbackURL, bytes.NewBuffer(b))
-		if err != nil {
-			return err
-		}
-		req.Header.Set("Content-Type", "application/json; charset=utf-8")
-		resp, err := client.Do(req)
-		if err != nil {
-			return err
-		}
-		defer resp.Body.Close()
+// PublishLowBalanceEvent sends a signer's low balance notification
+func (h *HTTP) PublishLowBalanceEvent(
+	callbackURL string,
+	signer mint.PublicKey,
+	token mint.Token,
+	balance, threshold *amount.Amount,
+) error {
+	// metrics
+	if h.metrics != nil {
+		defer func(t time.Time) {
+			h.metrics.NotificationDuration.Observe(time.Since(t).Seconds())
+		}(time.Now())
+	}
+
+	event := pkg.LowBalanceEvent{
+		PublicKey: signer.String(),
+		Token:     token.String(),
+		Balance:   balance.String(),
+		Threshold: threshold.String(),
+	}
+
+	b, err := json.Marshal(&event)
+	if err != nil {
+		return err
+	}
+	return h.postCallback(callbackURL, "", b)
+}
 
-		if resp.StatusCode != 200 {
-			return fmt.Errorf("callback status code is %v", resp.StatusCode)
+// postCallback posts JSON to the callback URL, body is signed with the service secret in case authentication is enabled.
+// Empty service means low balance callback signed with its own secret if set
+func (h *HTTP) postCallback(url, service string, b []byte) error {
+	timeoutSec := 10
+	transport := &http.Transport{
+		IdleConnTimeout: time.Second * time.Duration(timeoutSec),
+	}
+	client := &http.Client{
+		Timeout:   time.Second * time.Duration(timeoutSec),
+		Transport: transport,
+	}
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	switch {
+	case service == "":
+		if len(h.lbKey) > 0 {
+			auth.SignHTTP(req, h.lbKey, b)
 		}
+	case h.auth != nil:
+		h.auth.SignCallback(req, service, b)
 	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
 
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("callback status code is %v", resp.StatusCode)
+	}
 	return nil
 }
@@ -12,6 +12,25 @@ var ServiceNameRex = regexp.MustCompile("^[a-z0-9_]{1,64}$")
 // RequestIDRex is request ID pattern
 var RequestIDRex = regexp.MustCompile("^[a-z0-9]{1,64}$")
 
+// MaxBatchItems is max number of items within a batch request
+const MaxBatchItems = 1000
+
+// MaxPendingItems is max number of items within a pending sendings list
+const MaxPendingItems = 1000
+
+// MaxDeadItems is max number of items within a dead letters list
+const MaxDeadItems = 1000
+
+// Batch item results
+const (
+	BatchItemAccepted  = "accepted"
+	BatchItemDuplicate = "duplicate"
+	BatchItemInvalid   = "invalid"
+	BatchItemRejected  = "rejected"
+	BatchItemHeld      = "held"
+	BatchItemAwaiting  = "awaiting_approval"
+)
+
 // ValidCallback checker
 func ValidCallback(s string) bool {
 	u, err := url.Parse(s)
@@ -0,0 +1,35 @@
+package nats
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	"github.com/void616/gm.mint.sender/internal/auth"
+)
+
+// AddAuth enables authentication of requests and should be called before service launch
+func (n *Nats) AddAuth(k *auth.Keys) {
+	n.auth = k
+}
+
+// authenticate checks signature of the service request in case authentication is enabled
+func (n *Nats) authenticate(service string, timestamp int64, signature *string, req proto.Message) bool {
+	if n.auth == nil {
+		return true
+	}
+	if err := n.auth.VerifyMessage(service, timestamp, signature, req); err != nil {
+		n.logger.WithError(err).WithField("service", service).Warn("Request authentication failed")
+		return false
+	}
+	return true
+}
+
+// authenticateQuery checks signature of the read-only service request in case authentication is enabled, the request could be repeated
+func (n *Nats) authenticateQuery(service string, timestamp int64, signature *string, req proto.Message) bool {
+	if n.auth == nil {
+		return true
+	}
+	if err := n.auth.VerifyMessageQuery(service, timestamp, signature, req); err != nil {
+		n.logger.WithError(err).WithField("service", service).Warn("Request authentication failed")
+		return false
+	}
+	return true
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/auth"
 	"github.com/void616/gm.mint.sender/internal/sender/db/types"
 	senderNats "github.com/void616/gm.mint.sender/pkg/sender/nats"
 	"github.com/void616/gm.mint/amount"
@@ -20,26 +21,40 @@ type Nats struct {
 	api            API
 	natsConnection *gonats.Conn
 	metrics        *Metrics
+	auth           *auth.Keys
 }
 
 // API provides ability to interact with service API
 type API interface {
-	EnqueueSending(trans types.SendingTransport, id, service, callbackURL string, to mint.PublicKey, amo *amount.Amount, token mint.Token, ignoreApprovement bool) (dup, success bool)
+	EnqueueSending(trans types.SendingTransport, id, service, callbackURL string, to mint.PublicKey, amo *amount.Amount, token mint.Token, ignoreApprovement bool) (dup bool, rejected string, success bool)
+	EnqueueSendingBatch(trans types.SendingTransport, service, callbackURL string, list []*types.Sending) (dups []bool, rejects []string, success bool)
 	EnqueueApprovement(trans types.SendingTransport, id, service, callbackURL string, to mint.PublicKey) (dup, success bool)
+	GetSending(service, id string) (*types.Sending, bool)
+	GetApprovement(service, id string) (*types.Approvement, bool)
+	CancelSending(service, id string) (found, cancelled, success bool)
+	CancelApprovement(service, id string) (found, cancelled, success bool)
+	ListDeadSendings(service string, max uint16) ([]*types.Sending, bool)
+	ListDeadApprovements(service string, max uint16) ([]*types.Approvement, bool)
+	RedeliverSending(service, id string) (found, redelivered, success bool)
+	RedeliverApprovement(service, id string) (found, redelivered, success bool)
 }
 
 // New instance
 func New(
 	url string,
 	subjPrefix string,
+	opts []gonats.Option,
 	api API,
 	logger *logrus.Entry,
 ) (*Nats, func(), error) {
 
+	// opts provide TLS and credentials (see secure.Nats)
 	natsConnection, err := gonats.Connect(
 		url,
-		gonats.Name("mint_sender"),
-		gonats.MaxReconnects(-1),
+		append([]gonats.Option{
+			gonats.Name("mint_sender"),
+			gonats.MaxReconnects(-1),
+		}, opts...)...,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -92,6 +107,36 @@ func (n *Nats) Task(token *gotask.Token) {
 		n.logger.WithError(err).Errorf("Failed to subscribe to %v", n.subjPrefix+senderNats.Send{}.Subject())
 	}
 
+	// sub for sending batch requests
+	_, err = nc.Subscribe(n.subjPrefix+senderNats.SendBatch{}.Subject(), n.subSendBatchRequest)
+	if err != nil {
+		n.logger.WithError(err).Errorf("Failed to subscribe to %v", n.subjPrefix+senderNats.SendBatch{}.Subject())
+	}
+
+	// sub for status requests
+	_, err = nc.Subscribe(n.subjPrefix+senderNats.Status{}.Subject(), n.subStatusRequest)
+	if err != nil {
+		n.logger.WithError(err).Errorf("Failed to subscribe to %v", n.subjPrefix+senderNats.Status{}.Subject())
+	}
+
+	// sub for cancellation requests
+	_, err = nc.Subscribe(n.subjPrefix+senderNats.Cancel{}.Subject(), n.subCancelRequest)
+	if err != nil {
+		n.logger.WithError(err).Errorf("Failed to subscribe to %v", n.subjPrefix+senderNats.Cancel{}.Subject())
+	}
+
+	// sub for dead letters requests
+	_, err = nc.Subscribe(n.subjPrefix+senderNats.DeadLetters{}.Subject(), n.subDeadLettersRequest)
+	if err != nil {
+		n.logger.WithError(err).Errorf("Failed to subscribe to %v", n.subjPrefix+senderNats.DeadLetters{}.Subject())
+	}
+
+	// sub for redelivery requests
+	_, err = nc.Subscribe(n.subjPrefix+senderNats.Redeliver{}.Subject(), n.subRedeliverRequest)
+	if err != nil {
+		n.logger.WithError(err).Errorf("Failed to subscribe to %v", n.subjPrefix+senderNats.Redeliver{}.Subject())
+	}
+
 	// wait
 	for !token.Stopped() {
 		token.Sleep(time.Millisecond * 500)
@@ -17,6 +17,7 @@ func (n *Nats) PublishApprovedEvent(
 	service, requestID string,
 	to mint.PublicKey,
 	digest *mint.Digest,
+	confirmations uint64,
 ) error {
 	// metrics
 	if n.metrics != nil {
@@ -31,12 +32,13 @@ func (n *Nats) PublishApprovedEvent(
 	}
 
 	reqModel := senderNatsProto.Approved{
-		Success:     success,
-		Error:       msgerr,
-		Service:     service,
-		Id:          requestID,
-		PublicKey:   to.String(),
-		Transaction: transaction,
+		Success:       success,
+		Error:         msgerr,
+		Service:       service,
+		Id:            requestID,
+		PublicKey:     to.String(),
+		Transaction:   transaction,
+		Confirmations: confirmations,
 	}
 
 	req, err := proto.Marshal(&reqModel)
@@ -70,6 +72,7 @@ func (n *Nats) PublishSentEvent(
 	token mint.Token,
 	amo *amount.Amount,
 	digest *mint.Digest,
+	confirmations uint64,
 ) error {
 	// metrics
 	if n.metrics != nil {
@@ -84,14 +87,15 @@ func (n *Nats) PublishSentEvent(
 	}
 
 	reqModel := senderNatsProto.Sent{
-		Success:     success,
-		Error:       msgerr,
-		Service:     service,
-		Id:          requestID,
-		PublicKey:   to.String(),
-		Token:       token.String(),
-		Amount:      amo.String(),
-		Transaction: transaction,
+		Success:       success,
+		Error:         msgerr,
+		Service:       service,
+		Id:            requestID,
+		PublicKey:     to.String(),
+		Token:         token.String(),
+		Amount:        amo.String(),
+		Transaction:   transaction,
+		Confirmations: confirmations,
 	}
 
 	req, err := proto.Marshal(&reqModel)
@@ -115,3 +119,31 @@ func (n *Nats) PublishSentEvent(
 
 	return nil
 }
+
+// PublishLowBalanceEvent sends a signer's low balance notification (no reply expected)
+func (n *Nats) PublishLowBalanceEvent(
+	signer mint.PublicKey,
+	token mint.Token,
+	balance, threshold *amount.Amount,
+) error {
+	// metrics
+	if n.metrics != nil {
+		defer func(t time.Time) {
+			n.metrics.NotificationDuration.Observe(time.Since(t).Seconds())
+		}(time.Now())
+	}
+
+	reqModel := senderNatsProto.LowBalance{
+		PublicKey: signer.String(),
+		Token:     token.String(),
+		Balance:   balance.String(),
+		Threshold: threshold.String(),
+	}
+
+	req, err := proto.Marshal(&reqModel)
+	if err != nil {
+		return err
+	}
+
+	return n.natsConnection.Publish(n.subjPrefix+senderNatsProto.LowBalance{}.Subject(), req)
+}
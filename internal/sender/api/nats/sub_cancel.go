@@ -0,0 +1,78 @@
+package nats
+
+import (
+	"time"
+
+	proto "github.com/golang/protobuf/proto"
+	gonats "github.com/nats-io/nats.go"
+	"github.com/void616/gm.mint.sender/internal/sender/api/model"
+	senderNats "github.com/void616/gm.mint.sender/pkg/sender/nats"
+)
+
+// subCancelRequest listens for sending/approvement cancellation requests until connection draining
+func (n *Nats) subCancelRequest(m *gonats.Msg) {
+	nc := n.natsConnection
+
+	// metrics
+	if n.metrics != nil {
+		defer func(t time.Time, method string) {
+			n.metrics.RequestDuration.WithLabelValues("cancel").Observe(time.Since(t).Seconds())
+		}(time.Now(), m.Subject)
+	}
+
+	// parse
+	req := senderNats.Cancel{}
+	if err := proto.Unmarshal(m.Data, &req); err != nil {
+		n.logger.WithError(err).Error("Failed to unmarshal request")
+		return
+	}
+
+	n.logger.WithField("data", req.String()).Debug("Got cancellation request")
+
+	// reply
+	var rep = senderNats.CancelReply{}
+	defer func() {
+		rep.Success = rep.Error == ""
+		if b, err := proto.Marshal(&rep); err != nil {
+			n.logger.WithError(err).Error("Failed to marshal reply")
+		} else {
+			if err := nc.Publish(m.Reply, b); err != nil {
+				n.logger.WithError(err).Error("Failed to publish reply")
+			}
+		}
+	}()
+
+	// check req service
+	if !model.ServiceNameRex.MatchString(req.GetService()) {
+		rep.Error = "invalid service name"
+		return
+	}
+
+	// authenticate
+	if !n.authenticate(req.GetService(), req.GetTimestamp(), &req.Signature, &req) {
+		rep.Error = "unauthorized"
+		return
+	}
+
+	// check req id
+	if !model.RequestIDRex.MatchString(req.GetId()) {
+		rep.Error = "invalid request ID"
+		return
+	}
+
+	// cancel
+	var found, cancelled, ok bool
+	if req.GetApprovement() {
+		found, cancelled, ok = n.api.CancelApprovement(req.GetService(), req.GetId())
+	} else {
+		found, cancelled, ok = n.api.CancelSending(req.GetService(), req.GetId())
+	}
+	switch {
+	case !ok:
+		rep.Error = "internal failure"
+	case !found:
+		rep.Error = "request not found"
+	case !cancelled:
+		rep.Error = "request is not enqueued anymore"
+	}
+}
@@ -0,0 +1,145 @@
+package nats
+
+import (
+	"time"
+
+	proto "github.com/golang/protobuf/proto"
+	gonats "github.com/nats-io/nats.go"
+	"github.com/void616/gm.mint.sender/internal/sender/api/model"
+	senderNats "github.com/void616/gm.mint.sender/pkg/sender/nats"
+)
+
+// subDeadLettersRequest listens for requests to list the service requests with notification in dead letters until connection draining
+func (n *Nats) subDeadLettersRequest(m *gonats.Msg) {
+	nc := n.natsConnection
+
+	// metrics
+	if n.metrics != nil {
+		defer func(t time.Time, method string) {
+			n.metrics.RequestDuration.WithLabelValues("deadletters").Observe(time.Since(t).Seconds())
+		}(time.Now(), m.Subject)
+	}
+
+	// parse
+	req := senderNats.DeadLetters{}
+	if err := proto.Unmarshal(m.Data, &req); err != nil {
+		n.logger.WithError(err).Error("Failed to unmarshal request")
+		return
+	}
+
+	n.logger.WithField("data", req.String()).Debug("Got dead letters request")
+
+	// reply
+	var rep = senderNats.DeadLettersReply{}
+	defer func() {
+		rep.Success = rep.Error == ""
+		if b, err := proto.Marshal(&rep); err != nil {
+			n.logger.WithError(err).Error("Failed to marshal reply")
+		} else {
+			if err := nc.Publish(m.Reply, b); err != nil {
+				n.logger.WithError(err).Error("Failed to publish reply")
+			}
+		}
+	}()
+
+	// check req service
+	if !model.ServiceNameRex.MatchString(req.GetService()) {
+		rep.Error = "invalid service name"
+		return
+	}
+
+	// authenticate
+	if !n.authenticateQuery(req.GetService(), req.GetTimestamp(), &req.Signature, &req) {
+		rep.Error = "unauthorized"
+		return
+	}
+
+	// get
+	if req.GetApprovement() {
+		list, ok := n.api.ListDeadApprovements(req.GetService(), model.MaxDeadItems)
+		if !ok {
+			rep.Error = "internal failure"
+			return
+		}
+		for _, apv := range list {
+			rep.Ids = append(rep.Ids, apv.RequestID)
+		}
+	} else {
+		list, ok := n.api.ListDeadSendings(req.GetService(), model.MaxDeadItems)
+		if !ok {
+			rep.Error = "internal failure"
+			return
+		}
+		for _, snd := range list {
+			rep.Ids = append(rep.Ids, snd.RequestID)
+		}
+	}
+}
+
+// subRedeliverRequest listens for requests to redeliver notification from dead letters until connection draining
+func (n *Nats) subRedeliverRequest(m *gonats.Msg) {
+	nc := n.natsConnection
+
+	// metrics
+	if n.metrics != nil {
+		defer func(t time.Time, method string) {
+			n.metrics.RequestDuration.WithLabelValues("redeliver").Observe(time.Since(t).Seconds())
+		}(time.Now(), m.Subject)
+	}
+
+	// parse
+	req := senderNats.Redeliver{}
+	if err := proto.Unmarshal(m.Data, &req); err != nil {
+		n.logger.WithError(err).Error("Failed to unmarshal request")
+		return
+	}
+
+	n.logger.WithField("data", req.String()).Debug("Got redelivery request")
+
+	// reply
+	var rep = senderNats.RedeliverReply{}
+	defer func() {
+		rep.Success = rep.Error == ""
+		if b, err := proto.Marshal(&rep); err != nil {
+			n.logger.WithError(err).Error("Failed to marshal reply")
+		} else {
+			if err := nc.Publish(m.Reply, b); err != nil {
+				n.logger.WithError(err).Error("Failed to publish reply")
+			}
+		}
+	}()
+
+	// check req service
+	if !model.ServiceNameRex.MatchString(req.GetService()) {
+		rep.Error = "invalid service name"
+		return
+	}
+
+	// authenticate
+	if !n.authenticate(req.GetService(), req.GetTimestamp(), &req.Signature, &req) {
+		rep.Error = "unauthorized"
+		return
+	}
+
+	// check req id
+	if !model.RequestIDRex.MatchString(req.GetId()) {
+		rep.Error = "invalid request ID"
+		return
+	}
+
+	// redeliver
+	var found, redelivered, ok bool
+	if req.GetApprovement() {
+		found, redelivered, ok = n.api.RedeliverApprovement(req.GetService(), req.GetId())
+	} else {
+		found, redelivered, ok = n.api.RedeliverSending(req.GetService(), req.GetId())
+	}
+	switch {
+	case !ok:
+		rep.Error = "internal failure"
+	case !found:
+		rep.Error = "request not found"
+	case !redelivered:
+		rep.Error = "notification is not in dead letters"
+	}
+}
@@ -1,6 +1,7 @@
 package nats
 
 import (
+	"math/big"
 	"time"
 
 	proto "github.com/golang/protobuf/proto"
@@ -54,6 +55,12 @@ func (n *Nats) subSendRequest(m *gonats.Msg) {
 		return
 	}
 
+	// authenticate
+	if !n.authenticate(req.GetService(), req.GetTimestamp(), &req.Signature, &req) {
+		replyError = "unauthorized"
+		return
+	}
+
 	// check req id
 	if !model.RequestIDRex.MatchString(req.GetId()) {
 		replyError = "invalid request ID"
@@ -82,9 +89,11 @@ func (n *Nats) subSendRequest(m *gonats.Msg) {
 	}
 
 	// enqueue
-	if dups, ok := n.api.EnqueueSending(types.SendingNats, req.GetId(), req.GetService(), "", reqAddr, reqAmount, reqToken, req.GetIgnoreApprovement()); !ok {
+	if dups, rejected, ok := n.api.EnqueueSending(types.SendingNats, req.GetId(), req.GetService(), "", reqAddr, reqAmount, reqToken, req.GetIgnoreApprovement()); !ok {
 		if dups {
 			replyError = "request with the same ID registered"
+		} else if rejected != "" {
+			replyError = rejected
 		} else {
 			replyError = "internal failure"
 		}
@@ -92,6 +101,134 @@ func (n *Nats) subSendRequest(m *gonats.Msg) {
 	}
 }
 
+// subSendBatchRequest listens for a new sending batch requests until connection draining
+func (n *Nats) subSendBatchRequest(m *gonats.Msg) {
+	nc := n.natsConnection
+
+	// metrics
+	if n.metrics != nil {
+		defer func(t time.Time, method string) {
+			n.metrics.RequestDuration.WithLabelValues("send_batch").Observe(time.Since(t).Seconds())
+		}(time.Now(), m.Subject)
+	}
+
+	// parse
+	req := senderNats.SendBatch{}
+	if err := proto.Unmarshal(m.Data, &req); err != nil {
+		n.logger.WithError(err).Error("Failed to unmarshal request")
+		return
+	}
+
+	n.logger.WithField("service", req.GetService()).WithField("items", len(req.GetItems())).Debug("Got sending batch request")
+
+	// reply
+	var replyError string
+	var replyItems []*senderNats.SendBatchResult
+	defer func() {
+		rep := senderNats.SendBatchReply{
+			Success: replyError == "",
+			Error:   replyError,
+			Items:   replyItems,
+		}
+		if b, err := proto.Marshal(&rep); err != nil {
+			n.logger.WithError(err).Error("Failed to marshal reply")
+		} else {
+			if err := nc.Publish(m.Reply, b); err != nil {
+				n.logger.WithError(err).Error("Failed to publish reply")
+			}
+		}
+	}()
+
+	// check req service
+	if !model.ServiceNameRex.MatchString(req.GetService()) {
+		replyError = "invalid service name"
+		return
+	}
+
+	// authenticate
+	if !n.authenticate(req.GetService(), req.GetTimestamp(), &req.Signature, &req) {
+		replyError = "unauthorized"
+		return
+	}
+
+	// check items count
+	if len(req.GetItems()) == 0 || len(req.GetItems()) > model.MaxBatchItems {
+		replyError = "invalid number of items"
+		return
+	}
+
+	// validate items
+	results := make([]*senderNats.SendBatchResult, len(req.GetItems()))
+	list := make([]*types.Sending, 0, len(req.GetItems()))
+	index := make([]int, 0, len(req.GetItems()))
+	for i, v := range req.GetItems() {
+		results[i] = &senderNats.SendBatchResult{Id: v.GetId(), Result: model.BatchItemInvalid}
+
+		// check req id
+		if !model.RequestIDRex.MatchString(v.GetId()) {
+			results[i].Error = "invalid request ID"
+			continue
+		}
+
+		// parse wallet address
+		reqAddr, err := mint.ParsePublicKey(v.GetPublicKey())
+		if err != nil {
+			results[i].Error = "invalid public key"
+			continue
+		}
+
+		// parse token
+		reqToken, err := mint.ParseToken(v.GetToken())
+		if err != nil {
+			results[i].Error = "invalid token"
+			continue
+		}
+
+		// parse amount
+		reqAmount, err := amount.FromString(v.GetAmount())
+		if err != nil || reqAmount.Value.Cmp(new(big.Int)) <= 0 {
+			results[i].Error = "invalid amount"
+			continue
+		}
+
+		list = append(list, &types.Sending{
+			RequestID:         v.GetId(),
+			To:                reqAddr,
+			Token:             reqToken,
+			Amount:            reqAmount,
+			IgnoreApprovement: v.GetIgnoreApprovement(),
+		})
+		index = append(index, i)
+	}
+
+	// enqueue
+	if len(list) > 0 {
+		dups, rejects, ok := n.api.EnqueueSendingBatch(types.SendingNats, req.GetService(), "", list)
+		if !ok {
+			replyError = "internal failure"
+			return
+		}
+		for i, dup := range dups {
+			switch {
+			case rejects[i] != "":
+				results[index[i]].Result = model.BatchItemRejected
+				results[index[i]].Error = rejects[i]
+			case dup:
+				results[index[i]].Result = model.BatchItemDuplicate
+				results[index[i]].Error = "request with the same ID registered"
+			case list[i].Status == types.SendingHeld:
+				results[index[i]].Result = model.BatchItemHeld
+			case list[i].Status == types.SendingAwaitingApproval:
+				results[index[i]].Result = model.BatchItemAwaiting
+			default:
+				results[index[i]].Result = model.BatchItemAccepted
+			}
+		}
+	}
+
+	replyItems = results
+}
+
 // subApproveRequest listens for a new approvement requests until connection draining
 func (n *Nats) subApproveRequest(m *gonats.Msg) {
 	nc := n.natsConnection
@@ -134,6 +271,12 @@ func (n *Nats) subApproveRequest(m *gonats.Msg) {
 		return
 	}
 
+	// authenticate
+	if !n.authenticate(req.GetService(), req.GetTimestamp(), &req.Signature, &req) {
+		replyError = "unauthorized"
+		return
+	}
+
 	// check req id
 	if !model.RequestIDRex.MatchString(req.GetId()) {
 		replyError = "invalid request ID"
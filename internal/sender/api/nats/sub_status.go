@@ -0,0 +1,127 @@
+package nats
+
+import (
+	"time"
+
+	proto "github.com/golang/protobuf/proto"
+	gonats "github.com/nats-io/nats.go"
+	"github.com/void616/gm.mint.sender/internal/sender/api/model"
+	senderNats "github.com/void616/gm.mint.sender/pkg/sender/nats"
+)
+
+// subStatusRequest listens for sending/approvement status requests until connection draining
+func (n *Nats) subStatusRequest(m *gonats.Msg) {
+	nc := n.natsConnection
+
+	// metrics
+	if n.metrics != nil {
+		defer func(t time.Time, method string) {
+			n.metrics.RequestDuration.WithLabelValues("status").Observe(time.Since(t).Seconds())
+		}(time.Now(), m.Subject)
+	}
+
+	// parse
+	req := senderNats.Status{}
+	if err := proto.Unmarshal(m.Data, &req); err != nil {
+		n.logger.WithError(err).Error("Failed to unmarshal request")
+		return
+	}
+
+	n.logger.WithField("data", req.String()).Debug("Got status request")
+
+	// reply
+	var rep = senderNats.StatusReply{}
+	defer func() {
+		rep.Success = rep.Error == ""
+		if b, err := proto.Marshal(&rep); err != nil {
+			n.logger.WithError(err).Error("Failed to marshal reply")
+		} else {
+			if err := nc.Publish(m.Reply, b); err != nil {
+				n.logger.WithError(err).Error("Failed to publish reply")
+			}
+		}
+	}()
+
+	// check req service
+	if !model.ServiceNameRex.MatchString(req.GetService()) {
+		rep.Error = "invalid service name"
+		return
+	}
+
+	// authenticate
+	if !n.authenticateQuery(req.GetService(), req.GetTimestamp(), &req.Signature, &req) {
+		rep.Error = "unauthorized"
+		return
+	}
+
+	// check req id
+	if !model.RequestIDRex.MatchString(req.GetId()) {
+		rep.Error = "invalid request ID"
+		return
+	}
+
+	// approvement
+	if req.GetApprovement() {
+		apv, ok := n.api.GetApprovement(req.GetService(), req.GetId())
+		if !ok {
+			rep.Error = "internal failure"
+			return
+		}
+		if apv == nil {
+			rep.Error = "request not found"
+			return
+		}
+
+		rep.Status = apv.Status.String()
+		rep.PublicKey = apv.To.String()
+		rep.Notified = apv.Notified
+		if apv.Sender != nil {
+			rep.Sender = (*apv.Sender).String()
+		}
+		if apv.SenderNonce != nil {
+			rep.Nonce = *apv.SenderNonce
+		}
+		if apv.Digest != nil {
+			rep.Transaction = (*apv.Digest).String()
+		}
+		if apv.SentAtBlock != nil {
+			rep.SentAtBlock = apv.SentAtBlock.String()
+		}
+		if apv.Block != nil {
+			rep.Block = apv.Block.String()
+		}
+		return
+	}
+
+	// sending
+	snd, ok := n.api.GetSending(req.GetService(), req.GetId())
+	if !ok {
+		rep.Error = "internal failure"
+		return
+	}
+	if snd == nil {
+		rep.Error = "request not found"
+		return
+	}
+
+	rep.Status = snd.Status.String()
+	rep.PublicKey = snd.To.String()
+	rep.Token = snd.Token.String()
+	rep.Amount = snd.Amount.String()
+	rep.Notified = snd.Notified
+	if snd.Sender != nil {
+		rep.Sender = (*snd.Sender).String()
+	}
+	if snd.SenderNonce != nil {
+		rep.Nonce = *snd.SenderNonce
+	}
+	if snd.Digest != nil {
+		rep.Transaction = (*snd.Digest).String()
+	}
+	if snd.SentAtBlock != nil {
+		rep.SentAtBlock = snd.SentAtBlock.String()
+	}
+	if snd.Block != nil {
+		rep.Block = snd.Block.String()
+	}
+}
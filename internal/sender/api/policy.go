@@ -0,0 +1,118 @@
+package api
+
+import (
+	"fmt"
+	"time"
+
+	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/sender/db/types"
+	"github.com/void616/gm.mint/amount"
+)
+
+// DefaultPolicy is a name of the policy applied to services without own policy
+const DefaultPolicy = "*"
+
+// Policy limits spendings of a service
+type Policy struct {
+	// Limits by token, missing token is not limited
+	Limits map[mint.Token]Limit
+	// Hold parks exceeding sendings in held status for manual approval instead of rejection
+	Hold bool
+}
+
+// Limit of a service spending in some token, nil value disables the check
+type Limit struct {
+	PerRequest *amount.Amount
+	PerHour    *amount.Amount
+	PerDay     *amount.Amount
+}
+
+// AddPolicies limits spendings of the services by service name (see DefaultPolicy) and should be called before service launch
+func (a *API) AddPolicies(p map[string]*Policy) {
+	a.policies = p
+}
+
+// usage of a service in some token
+type usage struct {
+	hour *amount.Amount
+	day  *amount.Amount
+}
+
+// applyPolicy checks a list of sendings of the service against it's policy.
+// Exceeding sendings get held status or a rejection reason (empty string means sending is accepted).
+// Caller must hold policyLock until the accepted sendings are saved.
+func (a *API) applyPolicy(service string, list []*types.Sending) ([]string, error) {
+	rejects := make([]string, len(list))
+
+	p, ok := a.policies[service]
+	if !ok {
+		p, ok = a.policies[DefaultPolicy]
+	}
+	if !ok || p == nil {
+		return rejects, nil
+	}
+
+	now := time.Now()
+	used := make(map[mint.Token]*usage)
+	for i, snd := range list {
+		lim, ok := p.Limits[snd.Token]
+		if !ok {
+			continue
+		}
+
+		u, ok := used[snd.Token]
+		if !ok {
+			hour, err := a.dao.SumSendings(service, snd.Token, now.Add(-time.Hour))
+			if err != nil {
+				return nil, err
+			}
+			day, err := a.dao.SumSendings(service, snd.Token, now.Add(-time.Hour*24))
+			if err != nil {
+				return nil, err
+			}
+			u = &usage{hour, day}
+			used[snd.Token] = u
+		}
+
+		exceeds := func(used, limit *amount.Amount) bool {
+			if limit == nil {
+				return false
+			}
+			sum := amount.FromAmount(snd.Amount)
+			if used != nil {
+				sum.Value.Add(sum.Value, used.Value)
+			}
+			return sum.Value.Cmp(limit.Value) > 0
+		}
+
+		var reason string
+		switch {
+		case exceeds(nil, lim.PerRequest):
+			reason = fmt.Sprintf("amount exceeds per request limit of %v %v", lim.PerRequest.String(), snd.Token.String())
+		case exceeds(u.hour, lim.PerHour):
+			reason = fmt.Sprintf("amount exceeds per hour limit of %v %v", lim.PerHour.String(), snd.Token.String())
+		case exceeds(u.day, lim.PerDay):
+			reason = fmt.Sprintf("amount exceeds per day limit of %v %v", lim.PerDay.String(), snd.Token.String())
+		}
+
+		if reason == "" {
+			u.hour.Value.Add(u.hour.Value, snd.Amount.Value)
+			u.day.Value.Add(u.day.Value, snd.Amount.Value)
+			continue
+		}
+
+		l := a.logger.
+			WithField("service", service).
+			WithField("id", snd.RequestID).
+			WithField("amount", snd.Amount.String()).
+			WithField("token", snd.Token.String())
+		if p.Hold {
+			l.Warnf("Sending is held: %v", reason)
+			snd.Status = types.SendingHeld
+			continue
+		}
+		l.Warnf("Sending is rejected: %v", reason)
+		rejects[i] = reason
+	}
+	return rejects, nil
+}
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/sender/db/sqlite"
+	"github.com/void616/gm.mint.sender/internal/sender/db/types"
+	"github.com/void616/gm.mint/amount"
+)
+
+func TestEnqueueSendingBatchPolicy(t *testing.T) {
+	dao, err := sqlite.New("file:"+t.Name()+"?mode=memory&cache=shared", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dao.Close()
+	if err := dao.Migrate(); err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := New(dao, nil, logrus.NewEntry(logrus.New()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.AddPolicies(map[string]*Policy{
+		DefaultPolicy: {Limits: map[mint.Token]Limit{
+			mint.TokenGOLD: {PerHour: amount.MustFromString("11")},
+		}},
+	})
+
+	if _, rejected, ok := a.EnqueueSending(types.SendingHTTP, "a", "svc", "", mint.PublicKey{}, amount.MustFromString("6"), mint.TokenGOLD, false); !ok || rejected != "" {
+		t.Fatalf("sending is not enqueued: %v", rejected)
+	}
+
+	batch := func(items ...string) ([]bool, []string) {
+		list := make([]*types.Sending, 0)
+		for i := 0; i < len(items); i += 2 {
+			list = append(list, &types.Sending{
+				RequestID: items[i],
+				Token:     mint.TokenGOLD,
+				Amount:    amount.MustFromString(items[i+1]),
+			})
+		}
+		dups, rejects, ok := a.EnqueueSendingBatch(types.SendingHTTP, "svc", "", list)
+		if !ok {
+			t.Fatal("batch is not enqueued")
+		}
+		return dups, rejects
+	}
+
+	// duplicates (stored or repeated in the batch) don't count against the limit: 6 + 4 + 1 = 11
+	dups, rejects := batch("a", "6", "b", "4", "b", "4", "c", "1")
+	for i, want := range []bool{true, false, true, false} {
+		if dups[i] != want || rejects[i] != "" {
+			t.Fatalf("item %v: dup %v, rejected %q", i, dups[i], rejects[i])
+		}
+	}
+
+	// the limit is reached, duplicates are still reported as such
+	dups, rejects = batch("d", "1", "c", "1")
+	if dups[0] || rejects[0] == "" {
+		t.Fatalf("exceeding sending: dup %v, rejected %q", dups[0], rejects[0])
+	}
+	if !dups[1] || rejects[1] != "" {
+		t.Fatalf("duplicate sending: dup %v, rejected %q", dups[1], rejects[1])
+	}
+
+	used, err := dao.SumSendings("svc", mint.TokenGOLD, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if used.String() != amount.MustFromString("11").String() {
+		t.Fatalf("used %v", used.String())
+	}
+}
@@ -2,9 +2,11 @@ package db
 
 import (
 	"math/big"
+	"time"
 
 	mint "github.com/void616/gm.mint"
 	"github.com/void616/gm.mint.sender/internal/sender/db/types"
+	"github.com/void616/gm.mint/amount"
 )
 
 // DAO is a DB interface
@@ -21,29 +23,91 @@ type DAO interface {
 
 	// PutSending adds sending request
 	PutSending(v *types.Sending) error
+	// PutSendings adds a batch of sending requests in a single transaction, returns flags of duplicate requests (skipped)
+	PutSendings(list []*types.Sending) ([]bool, error)
+	// GetSending gets sending request by service name and request ID or nil
+	GetSending(service, requestID string) (*types.Sending, error)
 	// ListEnqueuedSendings gets a list of enqueued sending requests
 	ListEnqueuedSendings(max uint16) ([]*types.Sending, error)
 	// ListStaleSendings gets a list of stale posted requests
 	ListStaleSendings(elderThanBlockID *big.Int, max uint16) ([]*types.Sending, error)
-	// ListUnnotifiedSendings gets a list of requests without notification of requestor
-	ListUnnotifiedSendings(max uint16) ([]*types.Sending, error)
+	// ListPostedSendings gets a list of posted requests of the sender with nonce greater than specified
+	ListPostedSendings(sender mint.PublicKey, afterNonce uint64) ([]*types.Sending, error)
+	// ListUnnotifiedSendings gets a list of requests without notification of requestor in order of creation.
+	// Requests of a service are skipped while its earlier request awaits a retry or is being delivered, skip excludes services
+	ListUnnotifiedSendings(max uint16, skip []string) ([]*types.Sending, error)
 	// UpdateSending updates sending
 	UpdateSending(v *types.Sending) error
+	// LeaseSendingNotification leases unnotified sending for notification delivery until the time and counts delivery attempt, returns false if the sending is notified or leased already
+	LeaseSendingNotification(id uint64, until time.Time) (bool, error)
+	// CompleteSendingNotification releases the lease of the sending and marks it as notified or schedules next delivery attempt
+	CompleteSendingNotification(id uint64, notified bool, notifyAt time.Time) error
+	// SetSendingDeadLetter releases the lease of the sending and moves it's notification to dead letters (retries are exhausted)
+	SetSendingDeadLetter(id uint64) error
+	// ListDeadSendings gets a list of sendings with notification in dead letters (empty service means any service)
+	ListDeadSendings(service string, max uint16) ([]*types.Sending, error)
+	// RedeliverSending moves sending notification from dead letters back to delivery, returns false if there is no such sending in dead letters
+	RedeliverSending(service, requestID string) (bool, error)
+	// SetSendingPosted updates enqueued sending as posted, returns false if request is not enqueued anymore
+	SetSendingPosted(v *types.Sending) (bool, error)
+	// CancelSending marks enqueued (held or awaiting approval) sending as cancelled, returns false if request is not enqueued anymore
+	CancelSending(service, requestID string) (bool, error)
+	// SetSendingIncluded marks sending as included into the block (awaiting confirmations)
+	SetSendingIncluded(d mint.Digest, from mint.PublicKey, block *big.Int) error
+	// ListIncludedSendings gets a list of included (unconfirmed) sendings
+	ListIncludedSendings(max uint16) ([]*types.Sending, error)
 	// SetSendingConfirmed updates sending
 	SetSendingConfirmed(d mint.Digest, from mint.PublicKey, block *big.Int) error
+	// RevertSendings sets included/confirmed sendings after the fork block back to posted state (blockchain reorganization)
+	RevertSendings(fork *big.Int) error
+	// SumSendings sums amounts of the service sendings in the token created since the time (cancelled, failed, held and rejected sendings are skipped)
+	SumSendings(service string, token mint.Token, since time.Time) (*amount.Amount, error)
+	// ListPendingSendings gets a list of sendings awaiting approval or held
+	ListPendingSendings(max uint16) ([]*types.Sending, error)
+	// SetSendingApproved marks pending sending as enqueued, returns false if request is not pending anymore
+	SetSendingApproved(id uint64) (bool, error)
+	// SetSendingRejected marks pending sending as rejected, returns false if request is not pending anymore
+	SetSendingRejected(id uint64, reason string) (bool, error)
+	// PutSendingApproval adds an approval of pending sending, fails with duplicate error on repeated approval
+	PutSendingApproval(v *types.SendingApproval) error
+	// ListSendingApprovals gets a list of approvals of the sending
+	ListSendingApprovals(sendingID uint64) ([]*types.SendingApproval, error)
 
 	// PutApprovement adds approvement request
 	PutApprovement(v *types.Approvement) error
+	// GetApprovement gets approvement request by service name and request ID or nil
+	GetApprovement(service, requestID string) (*types.Approvement, error)
 	// ListEnqueuedApprovements gets a list of enqueued approvement requests
 	ListEnqueuedApprovements(max uint16) ([]*types.Approvement, error)
 	// ListStaleApprovements gets a list of stale posted requests
 	ListStaleApprovements(elderThanBlockID *big.Int, max uint16) ([]*types.Approvement, error)
-	// ListUnnotifiedApprovements gets a list of requests without notification of requestor
-	ListUnnotifiedApprovements(max uint16) ([]*types.Approvement, error)
+	// ListUnnotifiedApprovements gets a list of requests without notification of requestor in order of creation.
+	// Requests of a service are skipped while its earlier request awaits a retry or is being delivered, skip excludes services
+	ListUnnotifiedApprovements(max uint16, skip []string) ([]*types.Approvement, error)
 	// UpdateApprovement updates approvement
 	UpdateApprovement(v *types.Approvement) error
+	// LeaseApprovementNotification leases unnotified approvement for notification delivery until the time and counts delivery attempt, returns false if the approvement is notified or leased already
+	LeaseApprovementNotification(id uint64, until time.Time) (bool, error)
+	// CompleteApprovementNotification releases the lease of the approvement and marks it as notified or schedules next delivery attempt
+	CompleteApprovementNotification(id uint64, notified bool, notifyAt time.Time) error
+	// SetApprovementDeadLetter releases the lease of the approvement and moves it's notification to dead letters (retries are exhausted)
+	SetApprovementDeadLetter(id uint64) error
+	// ListDeadApprovements gets a list of approvements with notification in dead letters (empty service means any service)
+	ListDeadApprovements(service string, max uint16) ([]*types.Approvement, error)
+	// RedeliverApprovement moves approvement notification from dead letters back to delivery, returns false if there is no such approvement in dead letters
+	RedeliverApprovement(service, requestID string) (bool, error)
+	// SetApprovementPosted updates enqueued approvement as posted, returns false if request is not enqueued anymore
+	SetApprovementPosted(v *types.Approvement) (bool, error)
+	// CancelApprovement marks enqueued approvement as cancelled, returns false if request is not enqueued anymore
+	CancelApprovement(service, requestID string) (bool, error)
+	// SetApprovementIncluded marks approvement as included into the block (awaiting confirmations)
+	SetApprovementIncluded(d mint.Digest, from mint.PublicKey, block *big.Int) error
+	// ListIncludedApprovements gets a list of included (unconfirmed) approvements
+	ListIncludedApprovements(max uint16) ([]*types.Approvement, error)
 	// SetApprovementConfirmed updates approvement
 	SetApprovementConfirmed(d mint.Digest, from mint.PublicKey, block *big.Int) error
+	// RevertApprovements sets included/confirmed approvements after the fork block back to posted state (blockchain reorganization)
+	RevertApprovements(fork *big.Int) error
 
 	// EarliestBlock finds a minimal block ID at which a transaction has been sent
 	EarliestBlock() (*big.Int, bool, error)
@@ -45,4 +45,60 @@ var migrations = []*gormigrate.Migration{
 			return nil
 		},
 	},
+
+	// sendings: creation time to track services spendings
+	{
+		ID: "2026-10-18T10:31:07.482Z",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.
+				AutoMigrate(&model.Sending{}).
+				AddIndex("ix_sender_sendings_service_token_createdat", "service", "token", "created_at").
+				Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return nil
+		},
+	},
+
+	// sendings: manual approval
+	{
+		ID: "2026-10-18T11:04:52.917Z",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.
+				AutoMigrate(&model.Sending{}).
+				CreateTable(&model.SendingApproval{}).
+				Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.
+				DropTable(&model.SendingApproval{}).
+				Error
+		},
+	},
+	// sendings, approvements: notification delivery lease and attempts
+	{
+		ID: "2026-10-18T12:47:19.306Z",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.
+				AutoMigrate(&model.Sending{}).
+				AutoMigrate(&model.Approvement{}).
+				Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return nil
+		},
+	},
+	// sendings, approvements: notification dead letters
+	{
+		ID: "2026-10-18T13:58:02.144Z",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.
+				AutoMigrate(&model.Sending{}).
+				AutoMigrate(&model.Approvement{}).
+				Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return nil
+		},
+	},
 }
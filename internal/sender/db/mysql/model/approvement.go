@@ -11,21 +11,24 @@ import (
 
 // Approvement model
 type Approvement struct {
-	ID            uint64     `gorm:"PRIMARY_KEY;AUTO_INCREMENT:true;NOT NULL"`
-	Transport     uint8      `gorm:"NOT NULL"`
-	Service       string     `gorm:"SIZE:64;NOT NULL"`
-	Status        uint8      `gorm:"NOT NULL"`
-	To            []byte     `gorm:"SIZE:32;NOT NULL"`
-	Sender        []byte     `gorm:"SIZE:32"`
-	SenderNonce   *uint64    `gorm:""`
-	Digest        []byte     `gorm:"SIZE:32"`
-	SentAtBlock   []byte     `gorm:"SIZE:32"`
-	Block         []byte     `gorm:"SIZE:32"`
-	RequestID     string     `gorm:"SIZE:64;NOT NULL"`
-	CallbackURL   string     `gorm:"SIZE:256;NOT NULL"`
-	FirstNotifyAt *time.Time `gorm:""`
-	NotifyAt      *time.Time `gorm:""`
-	Notified      bool       `gorm:"NOT NULL"`
+	ID              uint64     `gorm:"PRIMARY_KEY;AUTO_INCREMENT:true;NOT NULL"`
+	Transport       uint8      `gorm:"NOT NULL"`
+	Service         string     `gorm:"SIZE:64;NOT NULL"`
+	Status          uint8      `gorm:"NOT NULL"`
+	To              []byte     `gorm:"SIZE:32;NOT NULL"`
+	Sender          []byte     `gorm:"SIZE:32"`
+	SenderNonce     *uint64    `gorm:""`
+	Digest          []byte     `gorm:"SIZE:32"`
+	SentAtBlock     []byte     `gorm:"SIZE:32"`
+	Block           []byte     `gorm:"SIZE:32"`
+	RequestID       string     `gorm:"SIZE:64;NOT NULL"`
+	CallbackURL     string     `gorm:"SIZE:256;NOT NULL"`
+	FirstNotifyAt   *time.Time `gorm:""`
+	NotifyAt        *time.Time `gorm:""`
+	Notified        bool       `gorm:"NOT NULL"`
+	NotifyAttempts  uint32     `gorm:"NOT NULL;DEFAULT:0"`
+	DeliveringUntil *time.Time `gorm:""`
+	DeadLetter      bool       `gorm:"NOT NULL;DEFAULT:false"`
 }
 
 // MapFrom mapping
@@ -66,6 +69,9 @@ func (s *Approvement) MapFrom(t *types.Approvement) error {
 	s.FirstNotifyAt = t.FirstNotifyAt
 	s.NotifyAt = t.NotifyAt
 	s.Notified = t.Notified
+	s.NotifyAttempts = t.NotifyAttempts
+	s.DeliveringUntil = t.DeliveringUntil
+	s.DeadLetter = t.DeadLetter
 	return nil
 }
 
@@ -106,20 +112,23 @@ func (s *Approvement) MapTo() (*types.Approvement, error) {
 	}
 
 	return &types.Approvement{
-		ID:            s.ID,
-		Transport:     types.SendingTransport(s.Transport),
-		Status:        types.SendingStatus(s.Status),
-		To:            to,
-		Sender:        sender,
-		SenderNonce:   s.SenderNonce,
-		Digest:        digest,
-		SentAtBlock:   sentAtBlock,
-		Block:         block,
-		Service:       s.Service,
-		RequestID:     s.RequestID,
-		CallbackURL:   s.CallbackURL,
-		FirstNotifyAt: s.FirstNotifyAt,
-		NotifyAt:      s.NotifyAt,
-		Notified:      s.Notified,
+		ID:              s.ID,
+		Transport:       types.SendingTransport(s.Transport),
+		Status:          types.SendingStatus(s.Status),
+		To:              to,
+		Sender:          sender,
+		SenderNonce:     s.SenderNonce,
+		Digest:          digest,
+		SentAtBlock:     sentAtBlock,
+		Block:           block,
+		Service:         s.Service,
+		RequestID:       s.RequestID,
+		CallbackURL:     s.CallbackURL,
+		FirstNotifyAt:   s.FirstNotifyAt,
+		NotifyAt:        s.NotifyAt,
+		Notified:        s.Notified,
+		NotifyAttempts:  s.NotifyAttempts,
+		DeliveringUntil: s.DeliveringUntil,
+		DeadLetter:      s.DeadLetter,
 	}, nil
 }
@@ -30,6 +30,11 @@ type Sending struct {
 	FirstNotifyAt     *time.Time `gorm:""`
 	NotifyAt          *time.Time `gorm:""`
 	Notified          bool       `gorm:"NOT NULL"`
+	NotifyAttempts    uint32     `gorm:"NOT NULL;DEFAULT:0"`
+	DeliveringUntil   *time.Time `gorm:""`
+	DeadLetter        bool       `gorm:"NOT NULL;DEFAULT:false"`
+	CreatedAt         *time.Time `gorm:""`
+	RejectReason      string     `gorm:"SIZE:256;NOT NULL;DEFAULT:''"`
 }
 
 // MapFrom mapping
@@ -73,6 +78,11 @@ func (s *Sending) MapFrom(t *types.Sending) error {
 	s.FirstNotifyAt = t.FirstNotifyAt
 	s.NotifyAt = t.NotifyAt
 	s.Notified = t.Notified
+	s.NotifyAttempts = t.NotifyAttempts
+	s.DeliveringUntil = t.DeliveringUntil
+	s.DeadLetter = t.DeadLetter
+	s.CreatedAt = t.CreatedAt
+	s.RejectReason = LimitStringField(t.RejectReason, 256)
 	return nil
 }
 
@@ -136,5 +146,10 @@ func (s *Sending) MapTo() (*types.Sending, error) {
 		FirstNotifyAt:     s.FirstNotifyAt,
 		NotifyAt:          s.NotifyAt,
 		Notified:          s.Notified,
+		NotifyAttempts:    s.NotifyAttempts,
+		DeliveringUntil:   s.DeliveringUntil,
+		DeadLetter:        s.DeadLetter,
+		CreatedAt:         s.CreatedAt,
+		RejectReason:      s.RejectReason,
 	}, nil
 }
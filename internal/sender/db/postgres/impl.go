@@ -0,0 +1,55 @@
+package postgres
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+	gormigrate "gopkg.in/gormigrate.v1"
+)
+
+// Available implementation
+func (d *Database) Available() bool {
+	return d.DB.DB().Ping() == nil
+}
+
+// DuplicateError implementation
+func (d *Database) DuplicateError(err error) bool {
+	if err != nil {
+		if perr, yes := err.(*pq.Error); yes {
+			return perr.Code == "23505" // unique_violation
+		}
+	}
+	return false
+}
+
+// MaxPacketError implementation
+func (d *Database) MaxPacketError(err error) bool {
+	if err != nil {
+		if perr, yes := err.(*pq.Error); yes {
+			return perr.Code == "54000" // program_limit_exceeded
+		}
+	}
+	return false
+}
+
+// Migrate implementation
+func (d *Database) Migrate() error {
+	opts := gormigrate.DefaultOptions
+	opts.TableName = d.tablePrefix + "dbmigrations"
+	mig := gormigrate.New(d.DB, opts, migrations)
+	return mig.Migrate()
+}
+
+// createSkipDuplicate creates a record within transaction and returns true if it's a duplicate.
+// Postgres aborts transaction on any error, so the insertion is wrapped with a savepoint
+func (d *Database) createSkipDuplicate(tx *gorm.DB, v interface{}) (bool, error) {
+	if err := tx.Exec("SAVEPOINT create_skip_duplicate").Error; err != nil {
+		return false, err
+	}
+	if err := tx.Create(v).Error; err != nil {
+		if !d.DuplicateError(err) {
+			return false, err
+		}
+		return true, tx.Exec("ROLLBACK TO SAVEPOINT create_skip_duplicate").Error
+	}
+	return false, tx.Exec("RELEASE SAVEPOINT create_skip_duplicate").Error
+}
@@ -0,0 +1,435 @@
+package postgres
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/sender/db/postgres/model"
+	"github.com/void616/gm.mint.sender/internal/sender/db/types"
+)
+
+// PutWallet implementation
+func (d *Database) PutWallet(v *types.Wallet) error {
+	m := &model.Wallet{}
+	if err := m.MapFrom(v); err != nil {
+		return err
+	}
+	if err := d.Create(m).Error; err != nil {
+		if !d.DuplicateError(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// PutSending implementation
+func (d *Database) PutSending(v *types.Sending) error {
+	m := &model.Sending{}
+	if err := m.MapFrom(v); err != nil {
+		return err
+	}
+	if err := d.Create(m).Error; err != nil {
+		return err
+	}
+	v.ID = m.ID
+	return nil
+}
+
+// PutSendings implementation
+func (d *Database) PutSendings(list []*types.Sending) ([]bool, error) {
+	mlist := make([]*model.Sending, len(list))
+	for i, v := range list {
+		m := &model.Sending{}
+		if err := m.MapFrom(v); err != nil {
+			return nil, err
+		}
+		mlist[i] = m
+	}
+
+	tx := d.Begin()
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	dups := make([]bool, len(list))
+	for i, m := range mlist {
+		dup, err := d.createSkipDuplicate(tx, m)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		dups[i] = dup
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	for i, m := range mlist {
+		if !dups[i] {
+			list[i].ID = m.ID
+		}
+	}
+	return dups, nil
+}
+
+// UpdateSending implementation
+func (d *Database) UpdateSending(v *types.Sending) error {
+	var m = &model.Sending{}
+	if err := m.MapFrom(v); err != nil {
+		return err
+	}
+	return d.Save(m).Error
+}
+
+// LeaseSendingNotification implementation
+func (d *Database) LeaseSendingNotification(id uint64, until time.Time) (bool, error) {
+	now := time.Now().UTC()
+	res := d.Model(&model.Sending{}).
+		Where(`"id"=? AND "notified"=false AND "dead_letter"=false AND ("delivering_until" IS NULL OR "delivering_until"<=?)`, id, now).
+		Update(
+			map[string]interface{}{
+				"delivering_until": until.UTC(),
+				"notify_attempts":  gorm.Expr(`"notify_attempts"+1`),
+				"first_notify_at":  gorm.Expr(`COALESCE("first_notify_at",?)`, now),
+			},
+		)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// CompleteSendingNotification implementation
+func (d *Database) CompleteSendingNotification(id uint64, notified bool, notifyAt time.Time) error {
+	return d.Model(&model.Sending{}).
+		Where(`"id"=?`, id).
+		Update(
+			map[string]interface{}{
+				"notified":         notified,
+				"notify_at":        notifyAt.UTC(),
+				"delivering_until": nil,
+			},
+		).
+		Error
+}
+
+// SetSendingDeadLetter implementation
+func (d *Database) SetSendingDeadLetter(id uint64) error {
+	return d.Model(&model.Sending{}).
+		Where(`"id"=?`, id).
+		Update(
+			map[string]interface{}{
+				"dead_letter":      true,
+				"delivering_until": nil,
+			},
+		).
+		Error
+}
+
+// RedeliverSending implementation
+func (d *Database) RedeliverSending(service, requestID string) (bool, error) {
+	res := d.Model(&model.Sending{}).
+		Where(`"service"=? AND "request_id"=? AND "dead_letter"=true AND "notified"=false`, service, requestID).
+		Update(
+			map[string]interface{}{
+				"dead_letter":     false,
+				"notify_attempts": 0,
+				"first_notify_at": nil,
+				"notify_at":       time.Now().UTC(),
+			},
+		)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// SetSendingPosted implementation
+func (d *Database) SetSendingPosted(v *types.Sending) (bool, error) {
+	var m = &model.Sending{}
+	if err := m.MapFrom(v); err != nil {
+		return false, err
+	}
+	res := d.Model(&model.Sending{}).
+		Where(`"id"=? AND "status"=?`, m.ID, uint8(types.SendingEnqueued)).
+		Update(
+			map[string]interface{}{
+				"status":        uint8(types.SendingPosted),
+				"sender":        m.Sender,
+				"sender_nonce":  m.SenderNonce,
+				"digest":        m.Digest,
+				"sent_at_block": m.SentAtBlock,
+			},
+		)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// CancelSending implementation
+func (d *Database) CancelSending(service, requestID string) (bool, error) {
+	res := d.Model(&model.Sending{}).
+		Where(`"service"=? AND "request_id"=? AND ("status"=? OR "status"=? OR "status"=?)`, service, requestID, uint8(types.SendingEnqueued), uint8(types.SendingHeld), uint8(types.SendingAwaitingApproval)).
+		Update("status", uint8(types.SendingCancelled))
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// SetSendingIncluded implementation
+func (d *Database) SetSendingIncluded(dig mint.Digest, from mint.PublicKey, block *big.Int) error {
+	return d.Model(&model.Sending{}).
+		Where(`"digest"=? AND "sender"=? AND "status"<>?`, dig.Bytes(), from.Bytes(), uint8(types.SendingConfirmed)).
+		Update(
+			map[string]interface{}{
+				"status": uint8(types.SendingIncluded),
+				"block":  block.Bytes(),
+			},
+		).
+		Limit(1).
+		Error
+}
+
+// SetSendingConfirmed implementation
+func (d *Database) SetSendingConfirmed(dig mint.Digest, from mint.PublicKey, block *big.Int) error {
+	return d.Model(&model.Sending{}).
+		Where(`"digest"=? AND "sender"=?`, dig.Bytes(), from.Bytes()).
+		Update(
+			map[string]interface{}{
+				"status": uint8(types.SendingConfirmed),
+				"block":  block.Bytes(),
+			},
+		).
+		Limit(1).
+		Error
+}
+
+// RevertSendings implementation
+func (d *Database) RevertSendings(fork *big.Int) error {
+	b := fork.Bytes()
+	return d.Model(&model.Sending{}).
+		Where(`("status"=? OR "status"=?) AND "block" IS NOT NULL AND (LENGTH("block")>? OR (LENGTH("block")=? AND "block">?))`, uint8(types.SendingIncluded), uint8(types.SendingConfirmed), len(b), len(b), b).
+		Update(
+			map[string]interface{}{
+				"status":           uint8(types.SendingPosted),
+				"block":            nil,
+				"sent_at_block":    b,
+				"notified":         false,
+				"notify_at":        nil,
+				"notify_attempts":  0,
+				"first_notify_at":  nil,
+				"delivering_until": nil,
+				"dead_letter":      false,
+			},
+		).
+		Error
+}
+
+// PutApprovement implementation
+func (d *Database) PutApprovement(v *types.Approvement) error {
+	m := &model.Approvement{}
+	if err := m.MapFrom(v); err != nil {
+		return err
+	}
+	if err := d.Create(m).Error; err != nil {
+		return err
+	}
+	v.ID = m.ID
+	return nil
+}
+
+// UpdateApprovement implementation
+func (d *Database) UpdateApprovement(v *types.Approvement) error {
+	var m = &model.Approvement{}
+	if err := m.MapFrom(v); err != nil {
+		return err
+	}
+	return d.Save(m).Error
+}
+
+// LeaseApprovementNotification implementation
+func (d *Database) LeaseApprovementNotification(id uint64, until time.Time) (bool, error) {
+	now := time.Now().UTC()
+	res := d.Model(&model.Approvement{}).
+		Where(`"id"=? AND "notified"=false AND "dead_letter"=false AND ("delivering_until" IS NULL OR "delivering_until"<=?)`, id, now).
+		Update(
+			map[string]interface{}{
+				"delivering_until": until.UTC(),
+				"notify_attempts":  gorm.Expr(`"notify_attempts"+1`),
+				"first_notify_at":  gorm.Expr(`COALESCE("first_notify_at",?)`, now),
+			},
+		)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// CompleteApprovementNotification implementation
+func (d *Database) CompleteApprovementNotification(id uint64, notified bool, notifyAt time.Time) error {
+	return d.Model(&model.Approvement{}).
+		Where(`"id"=?`, id).
+		Update(
+			map[string]interface{}{
+				"notified":         notified,
+				"notify_at":        notifyAt.UTC(),
+				"delivering_until": nil,
+			},
+		).
+		Error
+}
+
+// SetApprovementDeadLetter implementation
+func (d *Database) SetApprovementDeadLetter(id uint64) error {
+	return d.Model(&model.Approvement{}).
+		Where(`"id"=?`, id).
+		Update(
+			map[string]interface{}{
+				"dead_letter":      true,
+				"delivering_until": nil,
+			},
+		).
+		Error
+}
+
+// RedeliverApprovement implementation
+func (d *Database) RedeliverApprovement(service, requestID string) (bool, error) {
+	res := d.Model(&model.Approvement{}).
+		Where(`"service"=? AND "request_id"=? AND "dead_letter"=true AND "notified"=false`, service, requestID).
+		Update(
+			map[string]interface{}{
+				"dead_letter":     false,
+				"notify_attempts": 0,
+				"first_notify_at": nil,
+				"notify_at":       time.Now().UTC(),
+			},
+		)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// SetApprovementPosted implementation
+func (d *Database) SetApprovementPosted(v *types.Approvement) (bool, error) {
+	var m = &model.Approvement{}
+	if err := m.MapFrom(v); err != nil {
+		return false, err
+	}
+	res := d.Model(&model.Approvement{}).
+		Where(`"id"=? AND "status"=?`, m.ID, uint8(types.SendingEnqueued)).
+		Update(
+			map[string]interface{}{
+				"status":        uint8(types.SendingPosted),
+				"sender":        m.Sender,
+				"sender_nonce":  m.SenderNonce,
+				"digest":        m.Digest,
+				"sent_at_block": m.SentAtBlock,
+			},
+		)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// CancelApprovement implementation
+func (d *Database) CancelApprovement(service, requestID string) (bool, error) {
+	res := d.Model(&model.Approvement{}).
+		Where(`"service"=? AND "request_id"=? AND "status"=?`, service, requestID, uint8(types.SendingEnqueued)).
+		Update("status", uint8(types.SendingCancelled))
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// SetApprovementIncluded implementation
+func (d *Database) SetApprovementIncluded(dig mint.Digest, from mint.PublicKey, block *big.Int) error {
+	return d.Model(&model.Approvement{}).
+		Where(`"digest"=? AND "sender"=? AND "status"<>?`, dig.Bytes(), from.Bytes(), uint8(types.SendingConfirmed)).
+		Update(
+			map[string]interface{}{
+				"status": uint8(types.SendingIncluded),
+				"block":  block.Bytes(),
+			},
+		).
+		Limit(1).
+		Error
+}
+
+// SetApprovementConfirmed implementation
+func (d *Database) SetApprovementConfirmed(dig mint.Digest, from mint.PublicKey, block *big.Int) error {
+	return d.Model(&model.Approvement{}).
+		Where(`"digest"=? AND "sender"=?`, dig.Bytes(), from.Bytes()).
+		Update(
+			map[string]interface{}{
+				"status": uint8(types.SendingConfirmed),
+				"block":  block.Bytes(),
+			},
+		).
+		Limit(1).
+		Error
+}
+
+// RevertApprovements implementation
+func (d *Database) RevertApprovements(fork *big.Int) error {
+	b := fork.Bytes()
+	return d.Model(&model.Approvement{}).
+		Where(`("status"=? OR "status"=?) AND "block" IS NOT NULL AND (LENGTH("block")>? OR (LENGTH("block")=? AND "block">?))`, uint8(types.SendingIncluded), uint8(types.SendingConfirmed), len(b), len(b), b).
+		Update(
+			map[string]interface{}{
+				"status":           uint8(types.SendingPosted),
+				"block":            nil,
+				"sent_at_block":    b,
+				"notified":         false,
+				"notify_at":        nil,
+				"notify_attempts":  0,
+				"first_notify_at":  nil,
+				"delivering_until": nil,
+				"dead_letter":      false,
+			},
+		).
+		Error
+}
+
+// SetSendingApproved implementation
+func (d *Database) SetSendingApproved(id uint64) (bool, error) {
+	res := d.Model(&model.Sending{}).
+		Where(`"id"=? AND ("status"=? OR "status"=?)`, id, uint8(types.SendingAwaitingApproval), uint8(types.SendingHeld)).
+		Update("status", uint8(types.SendingEnqueued))
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// SetSendingRejected implementation
+func (d *Database) SetSendingRejected(id uint64, reason string) (bool, error) {
+	res := d.Model(&model.Sending{}).
+		Where(`"id"=? AND ("status"=? OR "status"=?)`, id, uint8(types.SendingAwaitingApproval), uint8(types.SendingHeld)).
+		Update(
+			map[string]interface{}{
+				"status":        uint8(types.SendingRejected),
+				"reject_reason": model.LimitStringField(reason, 256),
+			},
+		)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// PutSendingApproval implementation
+func (d *Database) PutSendingApproval(v *types.SendingApproval) error {
+	m := &model.SendingApproval{}
+	if err := m.MapFrom(v); err != nil {
+		return err
+	}
+	return d.Create(m).Error
+}
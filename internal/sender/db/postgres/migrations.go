@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/void616/gm.mint.sender/internal/sender/db/postgres/model"
+	gormigrate "gopkg.in/gormigrate.v1"
+)
+
+var migrations = []*gormigrate.Migration{
+
+	// initial
+	{
+		ID: "2026-10-18T08:41:26.817Z",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.
+				CreateTable(&model.Wallet{}).
+				CreateTable(&model.Sending{}).
+				AddUniqueIndex("ux_sender_sendings_service_requestid", "service", "request_id").
+				AddIndex("ix_sender_sendings_status", "status").
+				AddIndex("ix_sender_sendings_sentatblock", "sent_at_block").
+				CreateTable(&model.Approvement{}).
+				AddUniqueIndex("ux_sender_approvs_service_requestid", "service", "request_id").
+				AddIndex("ix_sender_approvs_status", "status").
+				AddIndex("ix_sender_approvs_sentatblock", "sent_at_block").
+				Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.
+				DropTable(&model.Approvement{}).
+				DropTable(&model.Sending{}).
+				DropTable(&model.Wallet{}).
+				Error
+		},
+	},
+
+	// sendings: creation time to track services spendings
+	{
+		ID: "2026-10-18T10:31:07.482Z",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.
+				AutoMigrate(&model.Sending{}).
+				AddIndex("ix_sender_sendings_service_token_createdat", "service", "token", "created_at").
+				Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return nil
+		},
+	},
+
+	// sendings: manual approval
+	{
+		ID: "2026-10-18T11:04:52.917Z",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.
+				AutoMigrate(&model.Sending{}).
+				CreateTable(&model.SendingApproval{}).
+				Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.
+				DropTable(&model.SendingApproval{}).
+				Error
+		},
+	},
+	// sendings, approvements: notification delivery lease and attempts
+	{
+		ID: "2026-10-18T12:47:19.306Z",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.
+				AutoMigrate(&model.Sending{}).
+				AutoMigrate(&model.Approvement{}).
+				Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return nil
+		},
+	},
+	// sendings, approvements: notification dead letters
+	{
+		ID: "2026-10-18T13:58:02.144Z",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.
+				AutoMigrate(&model.Sending{}).
+				AutoMigrate(&model.Approvement{}).
+				Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return nil
+		},
+	},
+}
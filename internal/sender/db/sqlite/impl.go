@@ -0,0 +1,40 @@
+package sqlite
+
+import (
+	sqlite3 "github.com/mattn/go-sqlite3"
+	gormigrate "gopkg.in/gormigrate.v1"
+)
+
+// Available implementation
+func (d *Database) Available() bool {
+	return d.DB.DB().Ping() == nil
+}
+
+// DuplicateError implementation
+func (d *Database) DuplicateError(err error) bool {
+	if err != nil {
+		if serr, yes := err.(sqlite3.Error); yes {
+			return serr.ExtendedCode == sqlite3.ErrConstraintUnique ||
+				serr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey
+		}
+	}
+	return false
+}
+
+// MaxPacketError implementation
+func (d *Database) MaxPacketError(err error) bool {
+	if err != nil {
+		if serr, yes := err.(sqlite3.Error); yes {
+			return serr.Code == sqlite3.ErrTooBig
+		}
+	}
+	return false
+}
+
+// Migrate implementation
+func (d *Database) Migrate() error {
+	opts := gormigrate.DefaultOptions
+	opts.TableName = d.tablePrefix + "dbmigrations"
+	mig := gormigrate.New(d.DB, opts, migrations)
+	return mig.Migrate()
+}
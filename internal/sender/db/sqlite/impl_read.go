@@ -0,0 +1,518 @@
+package sqlite
+
+import (
+	"math/big"
+	"time"
+
+	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/sender/db/sqlite/model"
+	"github.com/void616/gm.mint.sender/internal/sender/db/types"
+	"github.com/void616/gm.mint/amount"
+)
+
+// ListWallets implementation
+func (d *Database) ListWallets() ([]*types.Wallet, error) {
+	m := make([]*model.Wallet, 0)
+	res := d.Find(&m)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	list := make([]*types.Wallet, len(m))
+	for i, v := range m {
+		w, err := v.MapTo()
+		if err != nil {
+			return nil, err
+		}
+		list[i] = w
+	}
+	return list, nil
+}
+
+// GetSending implementation
+func (d *Database) GetSending(service, requestID string) (*types.Sending, error) {
+	m := &model.Sending{}
+	res := d.Model(&model.Sending{}).Where("`service`=? AND `request_id`=?", service, requestID).First(m)
+	if res.RecordNotFound() {
+		return nil, nil
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return m.MapTo()
+}
+
+// ListEnqueuedSendings implementation
+func (d *Database) ListEnqueuedSendings(max uint16) ([]*types.Sending, error) {
+	m := make([]*model.Sending, 0)
+	res := d.Where("`status`=?", uint8(types.SendingEnqueued)).Limit(max).Find(&m)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	list := make([]*types.Sending, len(m))
+	for i, v := range m {
+		s, err := v.MapTo()
+		if err != nil {
+			return nil, err
+		}
+		list[i] = s
+	}
+	return list, nil
+}
+
+// ListIncludedSendings implementation
+func (d *Database) ListIncludedSendings(max uint16) ([]*types.Sending, error) {
+	m := make([]*model.Sending, 0)
+	res := d.Where("`status`=?", uint8(types.SendingIncluded)).Order("`id`").Limit(max).Find(&m)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	list := make([]*types.Sending, len(m))
+	for i, v := range m {
+		s, err := v.MapTo()
+		if err != nil {
+			return nil, err
+		}
+		list[i] = s
+	}
+	return list, nil
+}
+
+// ListStaleSendings implementation
+func (d *Database) ListStaleSendings(elderThanBlockID *big.Int, max uint16) ([]*types.Sending, error) {
+	m := make([]*model.Sending, 0)
+	res := d.Where(
+		"`status`=? AND `sent_at_block` IS NOT NULL AND `sent_at_block`<?",
+		uint8(types.SendingPosted),
+		elderThanBlockID.Bytes(),
+	).
+		Limit(max).
+		Find(&m)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	list := make([]*types.Sending, len(m))
+	for i, v := range m {
+		s, err := v.MapTo()
+		if err != nil {
+			return nil, err
+		}
+		list[i] = s
+	}
+	return list, nil
+}
+
+// ListPostedSendings implementation
+func (d *Database) ListPostedSendings(sender mint.PublicKey, afterNonce uint64) ([]*types.Sending, error) {
+	m := make([]*model.Sending, 0)
+	res := d.Where(
+		"`status`=? AND `sender`=? AND `sender_nonce`>?",
+		uint8(types.SendingPosted),
+		sender[:],
+		afterNonce,
+	).
+		Find(&m)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	list := make([]*types.Sending, len(m))
+	for i, v := range m {
+		s, err := v.MapTo()
+		if err != nil {
+			return nil, err
+		}
+		list[i] = s
+	}
+	return list, nil
+}
+
+// ListUnnotifiedSendings implementation
+func (d *Database) ListUnnotifiedSendings(max uint16, skip []string) ([]*types.Sending, error) {
+	m := make([]*model.Sending, 0)
+
+	q := d.
+		Model(&model.Sending{}).
+		Where(
+			"(`status`=? OR `status`=? OR `status`=? OR `status`=?) AND `notified`=0 AND (`notify_at` IS NULL OR `notify_at`<=?) AND `dead_letter`=0 AND (`delivering_until` IS NULL OR `delivering_until`<=?)",
+			uint8(types.SendingConfirmed),
+			uint8(types.SendingFailed),
+			uint8(types.SendingCancelled),
+			uint8(types.SendingRejected),
+			time.Now().UTC(),
+			time.Now().UTC(),
+		).
+		Where(
+			// keep the order: earlier notification of the destination awaits a retry or is being delivered
+			"NOT EXISTS (SELECT 1 FROM `"+d.tablePrefix+"sendings` AS `p` WHERE `p`.`service`=`"+d.tablePrefix+"sendings`.`service` AND `p`.`id`<`"+d.tablePrefix+"sendings`.`id` AND `p`.`notified`=0 AND `p`.`dead_letter`=0 AND (`p`.`notify_at`>? OR `p`.`delivering_until`>?))",
+			time.Now().UTC(),
+			time.Now().UTC(),
+		)
+	if len(skip) > 0 {
+		q = q.Where("`service` NOT IN (?)", skip)
+	}
+
+	res := q.
+		Order("`id` asc").
+		Limit(max).
+		Find(&m)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	list := make([]*types.Sending, len(m))
+	for i, v := range m {
+		s, err := v.MapTo()
+		if err != nil {
+			return nil, err
+		}
+		list[i] = s
+	}
+	return list, nil
+}
+
+// ListDeadSendings implementation
+func (d *Database) ListDeadSendings(service string, max uint16) ([]*types.Sending, error) {
+	m := make([]*model.Sending, 0)
+
+	db := d.
+		Model(&model.Sending{}).
+		Where("`dead_letter`=1 AND `notified`=0")
+	if service != "" {
+		db = db.Where("`service`=?", service)
+	}
+	res := db.
+		Order("`id` ASC").
+		Limit(max).
+		Find(&m)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	list := make([]*types.Sending, len(m))
+	for i, v := range m {
+		s, err := v.MapTo()
+		if err != nil {
+			return nil, err
+		}
+		list[i] = s
+	}
+	return list, nil
+}
+
+// GetApprovement implementation
+func (d *Database) GetApprovement(service, requestID string) (*types.Approvement, error) {
+	m := &model.Approvement{}
+	res := d.Model(&model.Approvement{}).Where("`service`=? AND `request_id`=?", service, requestID).First(m)
+	if res.RecordNotFound() {
+		return nil, nil
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return m.MapTo()
+}
+
+// ListEnqueuedApprovements implementation
+func (d *Database) ListEnqueuedApprovements(max uint16) ([]*types.Approvement, error) {
+	m := make([]*model.Approvement, 0)
+	res := d.Where("`status`=?", uint8(types.SendingEnqueued)).Limit(max).Find(&m)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	list := make([]*types.Approvement, len(m))
+	for i, v := range m {
+		s, err := v.MapTo()
+		if err != nil {
+			return nil, err
+		}
+		list[i] = s
+	}
+	return list, nil
+}
+
+// ListIncludedApprovements implementation
+func (d *Database) ListIncludedApprovements(max uint16) ([]*types.Approvement, error) {
+	m := make([]*model.Approvement, 0)
+	res := d.Where("`status`=?", uint8(types.SendingIncluded)).Order("`id`").Limit(max).Find(&m)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	list := make([]*types.Approvement, len(m))
+	for i, v := range m {
+		s, err := v.MapTo()
+		if err != nil {
+			return nil, err
+		}
+		list[i] = s
+	}
+	return list, nil
+}
+
+// ListStaleApprovements implementation
+func (d *Database) ListStaleApprovements(elderThanBlockID *big.Int, max uint16) ([]*types.Approvement, error) {
+	m := make([]*model.Approvement, 0)
+	res := d.Where(
+		"`status`=? AND `sent_at_block` IS NOT NULL AND `sent_at_block`<?",
+		uint8(types.SendingPosted),
+		elderThanBlockID.Bytes(),
+	).
+		Limit(max).
+		Find(&m)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	list := make([]*types.Approvement, len(m))
+	for i, v := range m {
+		s, err := v.MapTo()
+		if err != nil {
+			return nil, err
+		}
+		list[i] = s
+	}
+	return list, nil
+}
+
+// ListUnnotifiedApprovements implementation
+func (d *Database) ListUnnotifiedApprovements(max uint16, skip []string) ([]*types.Approvement, error) {
+	m := make([]*model.Approvement, 0)
+
+	q := d.
+		Model(&model.Approvement{}).
+		Where(
+			"(`status`=? OR `status`=? OR `status`=?) AND `notified`=0 AND (`notify_at` IS NULL OR `notify_at`<=?) AND `dead_letter`=0 AND (`delivering_until` IS NULL OR `delivering_until`<=?)",
+			uint8(types.SendingConfirmed),
+			uint8(types.SendingFailed),
+			uint8(types.SendingCancelled),
+			time.Now().UTC(),
+			time.Now().UTC(),
+		).
+		Where(
+			// keep the order: earlier notification of the destination awaits a retry or is being delivered
+			"NOT EXISTS (SELECT 1 FROM `"+d.tablePrefix+"approvements` AS `p` WHERE `p`.`service`=`"+d.tablePrefix+"approvements`.`service` AND `p`.`id`<`"+d.tablePrefix+"approvements`.`id` AND `p`.`notified`=0 AND `p`.`dead_letter`=0 AND (`p`.`notify_at`>? OR `p`.`delivering_until`>?))",
+			time.Now().UTC(),
+			time.Now().UTC(),
+		)
+	if len(skip) > 0 {
+		q = q.Where("`service` NOT IN (?)", skip)
+	}
+
+	res := q.
+		Order("`id` asc").
+		Limit(max).
+		Find(&m)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	list := make([]*types.Approvement, len(m))
+	for i, v := range m {
+		s, err := v.MapTo()
+		if err != nil {
+			return nil, err
+		}
+		list[i] = s
+	}
+	return list, nil
+}
+
+// ListDeadApprovements implementation
+func (d *Database) ListDeadApprovements(service string, max uint16) ([]*types.Approvement, error) {
+	m := make([]*model.Approvement, 0)
+
+	db := d.
+		Model(&model.Approvement{}).
+		Where("`dead_letter`=1 AND `notified`=0")
+	if service != "" {
+		db = db.Where("`service`=?", service)
+	}
+	res := db.
+		Order("`id` ASC").
+		Limit(max).
+		Find(&m)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	list := make([]*types.Approvement, len(m))
+	for i, v := range m {
+		s, err := v.MapTo()
+		if err != nil {
+			return nil, err
+		}
+		list[i] = s
+	}
+	return list, nil
+}
+
+// EarliestBlock implementation
+func (d *Database) EarliestBlock() (*big.Int, bool, error) {
+
+	var min = new(big.Int)
+	var valid = false
+	var check = func(x *big.Int) {
+		if !valid {
+			min.Set(x)
+			valid = true
+			return
+		}
+		if x.Cmp(min) < 0 {
+			min.Set(x)
+		}
+	}
+
+	// check sendings
+	{
+		m := struct {
+			Earliest []byte
+		}{}
+		res := d.
+			Table(d.tablePrefix+"sendings").
+			Select("MIN(`sent_at_block`) as `earliest`").
+			Where("`status`=?", uint8(types.SendingPosted)).
+			First(&m)
+		if res.Error != nil {
+			return nil, false, res.Error
+		}
+		if len(m.Earliest) != 0 {
+			check(new(big.Int).SetBytes(m.Earliest))
+		}
+	}
+
+	// check approvements
+	{
+		m := struct {
+			Earliest []byte
+		}{}
+		res := d.
+			Table(d.tablePrefix+"approvements").
+			Select("MIN(`sent_at_block`) as `earliest`").
+			Where("`status`=?", uint8(types.SendingPosted)).
+			First(&m)
+		if res.Error != nil {
+			return nil, false, res.Error
+		}
+		if len(m.Earliest) != 0 {
+			check(new(big.Int).SetBytes(m.Earliest))
+		}
+	}
+
+	return min, valid, nil
+}
+
+// LatestSenderNonce implementation
+func (d *Database) LatestSenderNonce(sender mint.PublicKey) (uint64, error) {
+
+	var max uint64
+	var check = func(x uint64) {
+		if x > max {
+			max = x
+		}
+	}
+
+	// sendings
+	{
+		m := struct {
+			Latest uint64
+		}{}
+		res := d.
+			Table(d.tablePrefix+"sendings").
+			Select("COALESCE(MAX(`sender_nonce`), 0) as `latest`").
+			Where("`sender`=?", sender[:]).
+			First(&m)
+		if res.Error != nil {
+			return 0, res.Error
+		}
+		if m.Latest > 0 {
+			check(m.Latest)
+		}
+	}
+
+	// approvements
+	{
+		m := struct {
+			Latest uint64
+		}{}
+		res := d.
+			Table(d.tablePrefix+"approvements").
+			Select("COALESCE(MAX(`sender_nonce`), 0) as `latest`").
+			Where("`sender`=?", sender[:]).
+			First(&m)
+		if res.Error != nil {
+			return 0, res.Error
+		}
+		if m.Latest > 0 {
+			check(m.Latest)
+		}
+	}
+
+	return max, nil
+}
+
+// SumSendings implementation
+func (d *Database) SumSendings(service string, token mint.Token, since time.Time) (*amount.Amount, error) {
+	// amount is stored as a string with fixed precision (see amount.String), it's split into integral part and
+	// two halves of fractional part to be summed exactly with 64-bit integers
+	m := struct {
+		Integral uint64
+		FracHigh uint64
+		FracLow  uint64
+	}{}
+	res := d.
+		Table(d.tablePrefix+"sendings").
+		Select(
+			"COALESCE(SUM(CAST(substr(`amount`, 1, instr(`amount`, '.')-1) AS INTEGER)), 0) as `integral`, "+
+				"COALESCE(SUM(CAST(substr(`amount`, instr(`amount`, '.')+1, 9) AS INTEGER)), 0) as `frac_high`, "+
+				"COALESCE(SUM(CAST(substr(`amount`, instr(`amount`, '.')+10, 9) AS INTEGER)), 0) as `frac_low`",
+		).
+		Where(
+			"`service`=? AND `token`=? AND `created_at`>=? AND `status`<>? AND `status`<>? AND `status`<>? AND `status`<>?",
+			service, uint16(token), since, uint8(types.SendingCancelled), uint8(types.SendingFailed), uint8(types.SendingHeld), uint8(types.SendingRejected),
+		).
+		First(&m)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	sum := new(big.Int).SetUint64(m.Integral)
+	sum.Mul(sum, big.NewInt(1e9)).Add(sum, new(big.Int).SetUint64(m.FracHigh))
+	sum.Mul(sum, big.NewInt(1e9)).Add(sum, new(big.Int).SetUint64(m.FracLow))
+	return amount.FromBig(sum), nil
+}
+
+// ListPendingSendings implementation
+func (d *Database) ListPendingSendings(max uint16) ([]*types.Sending, error) {
+	m := make([]*model.Sending, 0)
+	res := d.
+		Where("`status`=? OR `status`=?", uint8(types.SendingAwaitingApproval), uint8(types.SendingHeld)).
+		Order("`id`").
+		Limit(max).
+		Find(&m)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	list := make([]*types.Sending, len(m))
+	for i, v := range m {
+		s, err := v.MapTo()
+		if err != nil {
+			return nil, err
+		}
+		list[i] = s
+	}
+	return list, nil
+}
+
+// ListSendingApprovals implementation
+func (d *Database) ListSendingApprovals(sendingID uint64) ([]*types.SendingApproval, error) {
+	m := make([]*model.SendingApproval, 0)
+	res := d.Where("`sending_id`=?", sendingID).Order("`created_at`").Find(&m)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	list := make([]*types.SendingApproval, len(m))
+	for i, v := range m {
+		a, err := v.MapTo()
+		if err != nil {
+			return nil, err
+		}
+		list[i] = a
+	}
+	return list, nil
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+
+	"github.com/void616/gm.mint.sender/internal/sender/db/types"
+)
+
+// SendingApproval model
+type SendingApproval struct {
+	SendingID uint64    `gorm:"PRIMARY_KEY;AUTO_INCREMENT:false;NOT NULL"`
+	Approver  string    `gorm:"PRIMARY_KEY;SIZE:64;NOT NULL"`
+	CreatedAt time.Time `gorm:"NOT NULL"`
+}
+
+// MapFrom mapping
+func (s *SendingApproval) MapFrom(t *types.SendingApproval) error {
+	s.SendingID = t.SendingID
+	s.Approver = LimitStringField(t.Approver, 64)
+	s.CreatedAt = t.CreatedAt
+	return nil
+}
+
+// MapTo mapping
+func (s *SendingApproval) MapTo() (*types.SendingApproval, error) {
+	return &types.SendingApproval{
+		SendingID: s.SendingID,
+		Approver:  s.Approver,
+		CreatedAt: s.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,87 @@
+package sqlite
+
+import (
+	"testing"
+	"time"
+
+	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/sender/db/types"
+	"github.com/void616/gm.mint/amount"
+)
+
+func TestListUnnotifiedSendings(t *testing.T) {
+	d, err := New("file:"+t.Name()+"?mode=memory&cache=shared", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	if err := d.Migrate(); err != nil {
+		t.Fatal(err)
+	}
+
+	ids := make(map[string]uint64)
+	for _, v := range []struct{ service, id string }{{"a", "a1"}, {"a", "a2"}, {"b", "b1"}, {"a", "a3"}} {
+		snd := &types.Sending{
+			Status:    types.SendingConfirmed,
+			Token:     mint.TokenGOLD,
+			Amount:    amount.MustFromString("1"),
+			Service:   v.service,
+			RequestID: v.id,
+		}
+		if err := d.PutSending(snd); err != nil {
+			t.Fatal(err)
+		}
+		ids[v.id] = snd.ID
+	}
+
+	expect := func(skip []string, want ...string) {
+		t.Helper()
+		list, err := d.ListUnnotifiedSendings(10, skip)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := make([]string, len(list))
+		for i, v := range list {
+			got[i] = v.RequestID
+		}
+		if len(got) != len(want) {
+			t.Fatalf("got %v, expected %v", got, want)
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				t.Fatalf("got %v, expected %v", got, want)
+			}
+		}
+	}
+
+	// in order of creation
+	expect(nil, "a1", "a2", "b1", "a3")
+	expect([]string{"a"}, "b1")
+
+	// later requests of the service wait for the one being delivered
+	if ok, err := d.LeaseSendingNotification(ids["a1"], time.Now().Add(time.Minute)); err != nil || !ok {
+		t.Fatal("failed to lease", err)
+	}
+	expect(nil, "b1")
+
+	// ...or awaiting a retry
+	if err := d.CompleteSendingNotification(ids["a1"], false, time.Now().Add(time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	expect(nil, "b1")
+
+	// retry is due
+	if err := d.CompleteSendingNotification(ids["a1"], false, time.Now().Add(-time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	expect(nil, "a1", "a2", "b1", "a3")
+
+	// notified and dead requests don't hold the service
+	if err := d.CompleteSendingNotification(ids["a1"], true, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetSendingDeadLetter(ids["a2"]); err != nil {
+		t.Fatal(err)
+	}
+	expect(nil, "b1", "a3")
+}
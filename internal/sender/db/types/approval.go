@@ -0,0 +1,10 @@
+package types
+
+import "time"
+
+// SendingApproval model is an approval of a pending sending by an administrator
+type SendingApproval struct {
+	SendingID uint64
+	Approver  string
+	CreatedAt time.Time
+}
@@ -9,19 +9,22 @@ import (
 
 // Approvement model
 type Approvement struct {
-	ID            uint64
-	Transport     SendingTransport
-	Status        SendingStatus
-	To            mint.PublicKey
-	Sender        *mint.PublicKey
-	SenderNonce   *uint64
-	Digest        *mint.Digest
-	SentAtBlock   *big.Int
-	Block         *big.Int
-	Service       string
-	RequestID     string
-	CallbackURL   string
-	FirstNotifyAt *time.Time
-	NotifyAt      *time.Time
-	Notified      bool
+	ID              uint64
+	Transport       SendingTransport
+	Status          SendingStatus
+	To              mint.PublicKey
+	Sender          *mint.PublicKey
+	SenderNonce     *uint64
+	Digest          *mint.Digest
+	SentAtBlock     *big.Int
+	Block           *big.Int
+	Service         string
+	RequestID       string
+	CallbackURL     string
+	FirstNotifyAt   *time.Time
+	NotifyAt        *time.Time
+	Notified        bool
+	NotifyAttempts  uint32
+	DeliveringUntil *time.Time
+	DeadLetter      bool
 }
@@ -28,4 +28,9 @@ type Sending struct {
 	FirstNotifyAt     *time.Time
 	NotifyAt          *time.Time
 	Notified          bool
+	NotifyAttempts    uint32
+	DeliveringUntil   *time.Time
+	DeadLetter        bool
+	CreatedAt         *time.Time
+	RejectReason      string
 }
@@ -18,8 +18,44 @@ const (
 	SendingEnqueued SendingStatus = 0
 	// SendingPosted means sender has sent a transaction
 	SendingPosted SendingStatus = 1
-	// SendingConfirmed means sent transaction is confirmed (shown in some block)
+	// SendingConfirmed means sent transaction is confirmed (shown in some block and buried under enough blocks)
 	SendingConfirmed SendingStatus = 2
 	// SendingFailed means failure
 	SendingFailed SendingStatus = 3
+	// SendingCancelled means request is cancelled by requestor before posting
+	SendingCancelled SendingStatus = 4
+	// SendingIncluded means sent transaction is shown in some block but isn't confirmed yet
+	SendingIncluded SendingStatus = 5
+	// SendingHeld means sending exceeds the service spending policy and awaits manual approval
+	SendingHeld SendingStatus = 6
+	// SendingAwaitingApproval means sending exceeds the approval threshold and awaits approval of administrators
+	SendingAwaitingApproval SendingStatus = 7
+	// SendingRejected means pending sending is rejected by administrator
+	SendingRejected SendingStatus = 8
 )
+
+// String implementation
+func (s SendingStatus) String() string {
+	switch s {
+	case SendingEnqueued:
+		return "enqueued"
+	case SendingPosted:
+		return "posted"
+	case SendingConfirmed:
+		return "confirmed"
+	case SendingFailed:
+		return "failed"
+	case SendingCancelled:
+		return "cancelled"
+	case SendingIncluded:
+		return "included"
+	case SendingHeld:
+		return "held"
+	case SendingAwaitingApproval:
+		return "awaiting_approval"
+	case SendingRejected:
+		return "rejected"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,41 @@
+package keys
+
+import (
+	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint/signer"
+)
+
+// Signer signs transactions digests on behalf of a wallet.
+// Implementation could keep the private key in memory or delegate signing to an external service
+type Signer interface {
+	// PublicKey of the wallet
+	PublicKey() mint.PublicKey
+	// Sign transaction digest
+	Sign(digest []byte) (mint.Signature, error)
+}
+
+var (
+	_ Signer = &Local{}
+)
+
+// Local signer keeps the private key in memory
+type Local struct {
+	signer *signer.Signer
+}
+
+// NewLocal instance
+func NewLocal(pvt mint.PrivateKey) *Local {
+	return &Local{
+		signer: signer.FromPrivateKey(pvt),
+	}
+}
+
+// PublicKey implementation
+func (l *Local) PublicKey() mint.PublicKey {
+	return l.signer.PublicKey()
+}
+
+// Sign implementation
+func (l *Local) Sign(digest []byte) (mint.Signature, error) {
+	return l.signer.Sign(digest), nil
+}
@@ -0,0 +1,102 @@
+package keys
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint/amount"
+	"github.com/void616/gm.mint/signer"
+	"github.com/void616/gm.mint/transaction"
+)
+
+func TestKeystore(t *testing.T) {
+	pvt := newKey(t)
+
+	b, err := EncryptKey(pvt, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := DecryptKey(b, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != pvt {
+		t.Fatal("decrypted key mismatch")
+	}
+
+	if _, err := DecryptKey(b, "wrong"); err == nil {
+		t.Fatal("wrong password is accepted")
+	}
+}
+
+func TestRemote(t *testing.T) {
+	local := NewLocal(newKey(t))
+	srv := httptest.NewServer(RemoteHandler("secret", local))
+	defer srv.Close()
+
+	remote := NewRemote(srv.URL, "secret", local.PublicKey())
+	to := newKey(t).PublicKey()
+
+	// remote signing is byte-identical to the local one
+	ta := &transaction.TransferAsset{Address: to, Token: mint.TokenGOLD, Amount: amount.MustFromString("1.5")}
+	want, err := SignTransferAsset(local, 1, ta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := SignTransferAsset(remote, 1, ta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got.Data, want.Data) || got.Digest != want.Digest || got.Signature != want.Signature {
+		t.Fatal("remote transfer signature mismatch")
+	}
+
+	tag := &transaction.SetWalletTag{Address: to, Tag: mint.WalletTagApproved}
+	want, err = SignSetWalletTag(local, 2, tag)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err = SignSetWalletTag(remote, 2, tag)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got.Data, want.Data) || got.Digest != want.Digest || got.Signature != want.Signature {
+		t.Fatal("remote tag signature mismatch")
+	}
+
+	// the same digest is signed again
+	if _, err := SignSetWalletTag(remote, 2, tag); err != nil {
+		t.Fatal(err)
+	}
+
+	// unknown signer
+	if _, err := SignSetWalletTag(NewRemote(srv.URL, "secret", to), 1, tag); err == nil {
+		t.Fatal("unknown signer is served")
+	}
+
+	// wrong secret
+	if _, err := SignSetWalletTag(NewRemote(srv.URL, "wrong", local.PublicKey()), 3, tag); err == nil {
+		t.Fatal("request with wrong secret is served")
+	}
+
+	// unsigned request
+	resp, err := http.Post(srv.URL, "application/json", bytes.NewBufferString(`{"public_key":"`+local.PublicKey().String()+`","digest":"1"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("unsigned request status is %v", resp.StatusCode)
+	}
+}
+
+func newKey(t *testing.T) mint.PrivateKey {
+	s, err := signer.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s.PrivateKey()
+}
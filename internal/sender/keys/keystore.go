@@ -0,0 +1,144 @@
+package keys
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	mint "github.com/void616/gm.mint"
+	"golang.org/x/crypto/scrypt"
+)
+
+// scrypt parameters of the new keystore files
+const (
+	scryptN = 1 << 18
+	scryptR = 8
+	scryptP = 1
+)
+
+// keystoreFile is a password-encrypted private key file
+type keystoreFile struct {
+	PublicKey string `json:"public_key"`
+	Crypto    struct {
+		KDF       string `json:"kdf"`
+		KDFParams struct {
+			N    int    `json:"n"`
+			R    int    `json:"r"`
+			P    int    `json:"p"`
+			Salt string `json:"salt"`
+		} `json:"kdfparams"`
+		Cipher     string `json:"cipher"`
+		Nonce      string `json:"nonce"`
+		Ciphertext string `json:"ciphertext"`
+	} `json:"crypto"`
+}
+
+// EncryptKey makes a keystore file contents: private key is encrypted with AES-256-GCM using the scrypt-derived password key
+func EncryptKey(pvt mint.PrivateKey, password string) ([]byte, error) {
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	key, err := scrypt.Key([]byte(password), salt, scryptN, scryptR, scryptP, 32)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	f := keystoreFile{}
+	f.PublicKey = pvt.PublicKey().String()
+	f.Crypto.KDF = "scrypt"
+	f.Crypto.KDFParams.N = scryptN
+	f.Crypto.KDFParams.R = scryptR
+	f.Crypto.KDFParams.P = scryptP
+	f.Crypto.KDFParams.Salt = hex.EncodeToString(salt)
+	f.Crypto.Cipher = "aes-256-gcm"
+	f.Crypto.Nonce = hex.EncodeToString(nonce)
+	f.Crypto.Ciphertext = hex.EncodeToString(gcm.Seal(nil, nonce, pvt.Bytes(), nil))
+	return json.MarshalIndent(&f, "", "  ")
+}
+
+// DecryptKey unlocks a private key from the keystore file contents
+func DecryptKey(b []byte, password string) (mint.PrivateKey, error) {
+	f := keystoreFile{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return mint.PrivateKey{}, err
+	}
+	if f.Crypto.KDF != "scrypt" {
+		return mint.PrivateKey{}, fmt.Errorf("unsupported kdf %v", f.Crypto.KDF)
+	}
+	if f.Crypto.Cipher != "aes-256-gcm" {
+		return mint.PrivateKey{}, fmt.Errorf("unsupported cipher %v", f.Crypto.Cipher)
+	}
+
+	salt, err := hex.DecodeString(f.Crypto.KDFParams.Salt)
+	if err != nil {
+		return mint.PrivateKey{}, err
+	}
+	nonce, err := hex.DecodeString(f.Crypto.Nonce)
+	if err != nil {
+		return mint.PrivateKey{}, err
+	}
+	ciphertext, err := hex.DecodeString(f.Crypto.Ciphertext)
+	if err != nil {
+		return mint.PrivateKey{}, err
+	}
+
+	key, err := scrypt.Key([]byte(password), salt, f.Crypto.KDFParams.N, f.Crypto.KDFParams.R, f.Crypto.KDFParams.P, 32)
+	if err != nil {
+		return mint.PrivateKey{}, err
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return mint.PrivateKey{}, err
+	}
+	if len(nonce) != gcm.NonceSize() {
+		return mint.PrivateKey{}, errors.New("invalid nonce")
+	}
+	plain, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return mint.PrivateKey{}, errors.New("invalid password")
+	}
+
+	pvt, err := mint.BytesToPrivateKey(plain)
+	if err != nil {
+		return mint.PrivateKey{}, err
+	}
+	if f.PublicKey != "" && pvt.PublicKey().String() != f.PublicKey {
+		return mint.PrivateKey{}, errors.New("public key mismatch")
+	}
+	return pvt, nil
+}
+
+// LoadKeystore unlocks a keystore file
+func LoadKeystore(path, password string) (*Local, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pvt, err := DecryptKey(b, password)
+	if err != nil {
+		return nil, err
+	}
+	return NewLocal(pvt), nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
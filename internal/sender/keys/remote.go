@@ -0,0 +1,163 @@
+package keys
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/auth"
+	"github.com/void616/gm.mint/signer"
+)
+
+var (
+	_ Signer = &Remote{}
+)
+
+// remoteService is a service name of the sender in the signing service requests authentication
+const remoteService = "sender"
+
+// RemoteRequest is a remote signing service request model
+type RemoteRequest struct {
+	PublicKey string `json:"public_key"` // Signer wallet address in Base58
+	Digest    string `json:"digest"`     // Transaction digest in Base58
+}
+
+// RemoteResponse is a remote signing service response model
+type RemoteResponse struct {
+	Signature string `json:"signature"`       // Digest signature in Base58
+	Error     string `json:"error,omitempty"` // Error contains error description in case of failure
+}
+
+// Remote signer delegates signing to an external service via HTTP, so the private key never enters the process
+type Remote struct {
+	url    string
+	secret []byte
+	public mint.PublicKey
+	client *http.Client
+}
+
+// NewRemote instance.
+// Service at `url` accepts RemoteRequest via POST and replies with RemoteResponse.
+// Requests are signed with the secret the same way as the API requests (see auth.SignHTTP)
+func NewRemote(url, secret string, pub mint.PublicKey) *Remote {
+	return &Remote{
+		url:    url,
+		secret: []byte(secret),
+		public: pub,
+		client: &http.Client{
+			Timeout: time.Second * 10,
+		},
+	}
+}
+
+// PublicKey implementation
+func (r *Remote) PublicKey() mint.PublicKey {
+	return r.public
+}
+
+// Sign implementation
+func (r *Remote) Sign(digest []byte) (mint.Signature, error) {
+	b, err := json.Marshal(&RemoteRequest{
+		PublicKey: r.public.String(),
+		Digest:    mint.Pack58(digest),
+	})
+	if err != nil {
+		return mint.Signature{}, err
+	}
+
+	req, err := http.NewRequest("POST", r.url, bytes.NewBuffer(b))
+	if err != nil {
+		return mint.Signature{}, err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	auth.SignHTTP(req, r.secret, b)
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return mint.Signature{}, err
+	}
+	defer resp.Body.Close()
+
+	rep := RemoteResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(&rep); err != nil {
+		return mint.Signature{}, fmt.Errorf("signing service status code is %v: %v", resp.StatusCode, err)
+	}
+	if resp.StatusCode != 200 {
+		return mint.Signature{}, fmt.Errorf("signing service status code is %v: %v", resp.StatusCode, rep.Error)
+	}
+
+	sig, err := mint.ParseSignature(rep.Signature)
+	if err != nil {
+		return mint.Signature{}, err
+	}
+
+	// ensure the service signs with expected key
+	if err := signer.Verify(r.public, digest, sig); err != nil {
+		return mint.Signature{}, fmt.Errorf("invalid signature: %v", err)
+	}
+	return sig, nil
+}
+
+// RemoteHandler serves RemoteRequest with a set of signers, requests must be signed with the secret.
+// It's a reference implementation of the signing service and could be used as a fake one in tests
+func RemoteHandler(secret string, signers ...Signer) http.Handler {
+	keys := auth.New(map[string]string{remoteService: secret}, 0)
+	byKey := make(map[mint.PublicKey]Signer)
+	for _, s := range signers {
+		byKey[s.PublicKey()] = s
+	}
+
+	reply := func(w http.ResponseWriter, code int, rep RemoteResponse) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(code)
+		json.NewEncoder(w).Encode(&rep)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			reply(w, http.StatusMethodNotAllowed, RemoteResponse{Error: "method not allowed"})
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			reply(w, http.StatusBadRequest, RemoteResponse{Error: "invalid request"})
+			return
+		}
+		if err := keys.VerifyHTTP(r, remoteService, body); err != nil {
+			reply(w, http.StatusUnauthorized, RemoteResponse{Error: "unauthorized"})
+			return
+		}
+
+		req := RemoteRequest{}
+		if err := json.Unmarshal(body, &req); err != nil {
+			reply(w, http.StatusBadRequest, RemoteResponse{Error: "invalid request"})
+			return
+		}
+		pub, err := mint.ParsePublicKey(req.PublicKey)
+		if err != nil {
+			reply(w, http.StatusBadRequest, RemoteResponse{Error: "invalid public key"})
+			return
+		}
+		digest, err := mint.Unpack58(req.Digest)
+		if err != nil {
+			reply(w, http.StatusBadRequest, RemoteResponse{Error: "invalid digest"})
+			return
+		}
+		s, ok := byKey[pub]
+		if !ok {
+			reply(w, http.StatusNotFound, RemoteResponse{Error: "unknown signer"})
+			return
+		}
+
+		sig, err := s.Sign(digest)
+		if err != nil {
+			reply(w, http.StatusInternalServerError, RemoteResponse{Error: "failed to sign"})
+			return
+		}
+		reply(w, http.StatusOK, RemoteResponse{Signature: sig.String()})
+	})
+}
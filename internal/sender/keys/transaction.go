@@ -0,0 +1,65 @@
+package keys
+
+import (
+	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint/serializer"
+	"github.com/void616/gm.mint/transaction"
+	"golang.org/x/crypto/sha3"
+)
+
+// SignTransferAsset makes signed asset transfer transaction
+func SignTransferAsset(s Signer, nonce uint64, tx *transaction.TransferAsset) (*transaction.SignedTransaction, error) {
+	ser := serializer.NewSerializer()
+	ser.PutUint64(nonce)            // nonce
+	ser.PutUint16(uint16(tx.Token)) // token
+	ser.PutPublicKey(s.PublicKey()) // signer public key
+	ser.PutPublicKey(tx.Address)    // address / public key
+	ser.PutAmount(tx.Amount)        // amount
+	return sign(s, ser)
+}
+
+// SignSetWalletTag makes signed wallet tag setting transaction
+func SignSetWalletTag(s Signer, nonce uint64, tx *transaction.SetWalletTag) (*transaction.SignedTransaction, error) {
+	ser := serializer.NewSerializer()
+	ser.PutUint64(nonce)            // nonce
+	ser.PutPublicKey(s.PublicKey()) // signer public key
+	ser.PutPublicKey(tx.Address)    // address / public key
+	ser.PutByte(uint8(tx.Tag))      // tag
+	return sign(s, ser)
+}
+
+// sign appends a signature of the payload digest to the payload
+func sign(s Signer, ser *serializer.Serializer) (*transaction.SignedTransaction, error) {
+	payload, err := ser.Data()
+	if err != nil {
+		return nil, err
+	}
+
+	// payload digest
+	var digest mint.Digest
+	{
+		hasher := sha3.New256()
+		if _, err := hasher.Write(payload); err != nil {
+			return nil, err
+		}
+		copy(digest[:], hasher.Sum(nil))
+	}
+
+	sig, err := s.Sign(digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	ser.PutByte(1)       // signed bit
+	ser.PutBytes(sig[:]) // signature
+	data, err := ser.Data()
+	if err != nil {
+		return nil, err
+	}
+
+	return &transaction.SignedTransaction{
+		Digest:    digest,
+		Data:      data,
+		Signature: sig,
+	}, nil
+}
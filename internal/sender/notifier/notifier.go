@@ -1,45 +1,111 @@
 package notifier
 
 import (
+	"math/big"
+	"time"
+
 	"github.com/sirupsen/logrus"
 	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/alert"
+	"github.com/void616/gm.mint.sender/internal/retry"
 	"github.com/void616/gm.mint.sender/internal/sender/db"
+	"github.com/void616/gm.mint.sender/internal/sender/db/types"
+	"github.com/void616/gm.mint.sender/internal/wakeup"
 	"github.com/void616/gm.mint/amount"
 )
 
 const itemsPerShot = 50
 
+// defaultWorkers is a default number of concurrent deliveries
+const defaultWorkers = 8
+
+// defaultHostWorkers is a default number of concurrent deliveries to the same callback host
+const defaultHostWorkers = 2
+
+// deliveryLease is max duration of a notification delivery attempt, unfinished delivery is retried once it expires
+const deliveryLease = time.Minute * 2
+
 // Notifier sends refilling notifications
 type Notifier struct {
 	logger          *logrus.Entry
+	alerter         alert.Alerter
 	natsTransporter NatsTransporter
 	httpTransporter HTTPTransporter
+	blockObserver   BlockObserver
 	dao             db.DAO
+	retry           *retry.Policies
+	workers         uint16
+	hostWorkers     uint16
+	wakeup          *wakeup.Bus
 }
 
 // NatsTransporter delivers notifications via Nats or fail with an error
 type NatsTransporter interface {
-	PublishSentEvent(ok bool, err string, service, id string, to mint.PublicKey, t mint.Token, a *amount.Amount, d *mint.Digest) error
-	PublishApprovedEvent(ok bool, err string, service, id string, to mint.PublicKey, d *mint.Digest) error
+	PublishSentEvent(ok bool, err string, service, id string, to mint.PublicKey, t mint.Token, a *amount.Amount, d *mint.Digest, confirmations uint64) error
+	PublishApprovedEvent(ok bool, err string, service, id string, to mint.PublicKey, d *mint.Digest, confirmations uint64) error
 }
 
 // HTTPTransporter delivers notifications via Nats or fail with an error
 type HTTPTransporter interface {
-	PublishSentEvent(ok bool, err string, service, id, url string, to mint.PublicKey, t mint.Token, a *amount.Amount, d *mint.Digest) error
-	PublishApprovedEvent(ok bool, err string, service, id, url string, to mint.PublicKey, d *mint.Digest) error
+	PublishSentEvent(ok bool, err string, service, id, url string, to mint.PublicKey, t mint.Token, a *amount.Amount, d *mint.Digest, confirmations uint64) error
+	PublishApprovedEvent(ok bool, err string, service, id, url string, to mint.PublicKey, d *mint.Digest, confirmations uint64) error
+}
+
+// BlockObserver provides latest parsed block ID to calculate transaction depth
+type BlockObserver interface {
+	LatestBlock() *big.Int
 }
 
 // New Notifier instance
 func New(
 	dao db.DAO,
 	natsTrans NatsTransporter, httpTrans HTTPTransporter,
+	blockObserver BlockObserver,
+	alerter alert.Alerter,
 	logger *logrus.Entry,
 ) (*Notifier, error) {
 	n := &Notifier{
 		logger:          logger,
+		alerter:         alerter,
 		dao:             dao,
 		natsTransporter: natsTrans,
 		httpTransporter: httpTrans,
+		blockObserver:   blockObserver,
+		workers:         defaultWorkers,
+		hostWorkers:     defaultHostWorkers,
 	}
 	return n, nil
 }
+
+// AddRetry sets notification retry policies by service (see retry.Default() otherwise) and should be called before service launch
+func (n *Notifier) AddRetry(p *retry.Policies) {
+	n.retry = p
+}
+
+// AddWakeup sets a bus to deliver fresh notifications immediately and should be called before service launch
+func (n *Notifier) AddWakeup(b *wakeup.Bus) {
+	n.wakeup = b
+}
+
+// AddWorkers sets a number of concurrent deliveries and concurrent deliveries to the same callback host (zero to keep defaults).
+// Notifications of the same service are delivered one by one in order. Should be called before service launch
+func (n *Notifier) AddWorkers(workers, hostWorkers uint16) {
+	if workers > 0 {
+		n.workers = workers
+	}
+	if hostWorkers > 0 {
+		n.hostWorkers = hostWorkers
+	}
+}
+
+// confirmations returns a number of blocks on top of the confirmed transaction's block
+func (n *Notifier) confirmations(status types.SendingStatus, block *big.Int) uint64 {
+	if status != types.SendingConfirmed || block == nil || n.blockObserver == nil {
+		return 0
+	}
+	depth := new(big.Int).Sub(n.blockObserver.LatestBlock(), block)
+	if depth.Sign() < 0 || !depth.IsUint64() {
+		return 0
+	}
+	return depth.Uint64()
+}
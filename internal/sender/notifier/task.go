@@ -1,13 +1,24 @@
 package notifier
 
 import (
+	"net/url"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/sirupsen/logrus"
+	"github.com/void616/gm.mint.sender/internal/delivery"
 	"github.com/void616/gm.mint.sender/internal/sender/db/types"
+	"github.com/void616/gm.mint.sender/internal/wakeup"
 	"github.com/void616/gotask"
 )
 
+// destinations prefixes
+const (
+	sendingDestination     = "sending:"
+	approvementDestination = "approvement:"
+)
+
 // Task loop
 func (n *Notifier) Task(token *gotask.Token) {
 
@@ -19,12 +30,34 @@ func (n *Notifier) Task(token *gotask.Token) {
 		case <-time.After(d):
 		}
 	}
+	var wait = func(kicked <-chan struct{}, d time.Duration) {
+		select {
+		case <-stopper:
+		case <-kicked:
+		case <-time.After(d):
+		}
+	}
+
+	pool := delivery.New(int(n.workers), int(n.hostWorkers))
+	pool.Start()
+
+	// busy gets services with queued or running deliveries
+	var busy = func(prefix string) []string {
+		list := make([]string, 0)
+		for _, d := range pool.Destinations() {
+			if strings.HasPrefix(d, prefix) {
+				list = append(list, strings.TrimPrefix(d, prefix))
+			}
+		}
+		return list
+	}
 
 	// approvements
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
 		logger := n.logger.WithField("approvements", "")
+		kicked := n.wakeup.Subscribe(wakeup.Notification)
 
 		for {
 			select {
@@ -33,8 +66,15 @@ func (n *Notifier) Task(token *gotask.Token) {
 			default:
 			}
 
+			// enough work
+			if pool.Jobs() >= itemsPerShot {
+				sleep(time.Second)
+				continue
+			}
+
 			// get list
-			list, err := n.dao.ListUnnotifiedApprovements(itemsPerShot)
+			skip := busy(approvementDestination)
+			list, err := n.dao.ListUnnotifiedApprovements(itemsPerShot, skip)
 			if err != nil {
 				logger.WithError(err).Error("Failed to get unnotified requests")
 				sleep(time.Second * 30)
@@ -43,114 +83,23 @@ func (n *Notifier) Task(token *gotask.Token) {
 
 			// nothing
 			if len(list) == 0 {
-				sleep(time.Second * 30)
+				if len(skip) > 0 {
+					sleep(time.Second)
+				} else {
+					wait(kicked, time.Second*30)
+				}
 				continue
 			}
 
-			out := false
 			for _, snd := range list {
-				if out {
-					break
-				}
-
-				// mark as notified
-				{
-					now := time.Now().UTC()
-					if snd.FirstNotifyAt == nil {
-						snd.FirstNotifyAt = &now
-					}
-					snd.NotifyAt = &now
-					snd.Notified = true
-				}
-				if err := n.dao.UpdateApprovement(snd); err != nil {
-					logger.
-						WithError(err).
-						WithField("id", snd.ID).
-						Error("Failed to update request")
-					sleep(time.Second * 30)
-					out = true
-					continue
-				}
-
-				notiErrorDesc := "Transaction failed"
-				if snd.Status == types.SendingConfirmed {
-					notiErrorDesc = ""
-				}
-
-				// notify
-				var notiErr error
-				switch snd.Transport {
-				case types.SendingNats:
-					if n.natsTransporter != nil {
-						notiErr = n.natsTransporter.PublishApprovedEvent(
-							snd.Status == types.SendingConfirmed,
-							notiErrorDesc,
-							snd.Service, snd.RequestID,
-							snd.To, snd.Digest,
-						)
-					} else {
-						logger.Warn("Nats transport is disabled, skipping notification")
-						continue
-					}
-				case types.SendingHTTP:
-					if n.httpTransporter != nil {
-						if snd.CallbackURL != "" {
-							notiErr = n.httpTransporter.PublishApprovedEvent(
-								snd.Status == types.SendingConfirmed,
-								notiErrorDesc,
-								snd.Service, snd.RequestID, snd.CallbackURL,
-								snd.To, snd.Digest,
-							)
-						}
-					} else {
-						logger.Warn("HTTP transport is disabled, skipping notification")
-						continue
-					}
-				default:
-					logger.Errorf("Transport %v is not implemented", snd.Transport)
-					continue
-				}
-
-				if notiErr != nil {
-					logger.WithField("id", snd.ID).WithError(notiErr).Error("Failed to notify")
-
-					// notify next time
-					when := time.Now().UTC()
-					if snd.FirstNotifyAt != nil {
-						mikes := time.Now().UTC().Sub(*snd.FirstNotifyAt).Minutes()
-						switch {
-						// for 5m: every 1m
-						case mikes < 5:
-							when = when.Add(time.Minute)
-						// then for 30m: every 5m
-						case mikes < 35:
-							when = when.Add(time.Minute * 5)
-						// then for 60m: every 10m
-						case mikes < 95:
-							when = when.Add(time.Minute * 10)
-						// then every 120m
-						default:
-							when = when.Add(time.Minute * 120)
-						}
-					} else {
-						when = when.Add(time.Hour * 24 * 365)
-					}
-
-					// mark as unnotified
-					snd.NotifyAt = &when
-					snd.Notified = false
-					if err := n.dao.UpdateApprovement(snd); err != nil {
-						logger.
-							WithError(err).
-							WithField("id", snd.ID).
-							Error("Failed to update request")
-						sleep(time.Second * 30)
-						out = true
-						continue
-					}
-				} else {
-					logger.WithField("id", snd.ID).Debug("Notified")
-				}
+				snd := snd
+				pool.Submit(delivery.Job{
+					Destination: approvementDestination + snd.Service,
+					Host:        callbackHost(snd.Transport, snd.CallbackURL),
+					Run: func() bool {
+						return n.notifyApprovement(snd, logger)
+					},
+				})
 			}
 		}
 	}()
@@ -160,6 +109,7 @@ func (n *Notifier) Task(token *gotask.Token) {
 	go func() {
 		defer wg.Done()
 		logger := n.logger.WithField("sendings", "")
+		kicked := n.wakeup.Subscribe(wakeup.Notification)
 
 		for {
 			select {
@@ -168,8 +118,15 @@ func (n *Notifier) Task(token *gotask.Token) {
 			default:
 			}
 
+			// enough work
+			if pool.Jobs() >= itemsPerShot {
+				sleep(time.Second)
+				continue
+			}
+
 			// get list
-			list, err := n.dao.ListUnnotifiedSendings(itemsPerShot)
+			skip := busy(sendingDestination)
+			list, err := n.dao.ListUnnotifiedSendings(itemsPerShot, skip)
 			if err != nil {
 				logger.WithError(err).Error("Failed to get unnotified requests")
 				sleep(time.Second * 30)
@@ -178,114 +135,23 @@ func (n *Notifier) Task(token *gotask.Token) {
 
 			// nothing
 			if len(list) == 0 {
-				sleep(time.Second * 30)
+				if len(skip) > 0 {
+					sleep(time.Second)
+				} else {
+					wait(kicked, time.Second*30)
+				}
 				continue
 			}
 
-			out := false
 			for _, snd := range list {
-				if out {
-					break
-				}
-
-				// mark as notified
-				{
-					now := time.Now().UTC()
-					if snd.FirstNotifyAt == nil {
-						snd.FirstNotifyAt = &now
-					}
-					snd.NotifyAt = &now
-					snd.Notified = true
-				}
-				if err := n.dao.UpdateSending(snd); err != nil {
-					logger.
-						WithError(err).
-						WithField("id", snd.ID).
-						Error("Failed to update request")
-					sleep(time.Second * 30)
-					out = true
-					continue
-				}
-
-				notiErrorDesc := "Transaction failed"
-				if snd.Status == types.SendingConfirmed {
-					notiErrorDesc = ""
-				}
-
-				// notify
-				var notiErr error
-				switch snd.Transport {
-				case types.SendingNats:
-					if n.natsTransporter != nil {
-						notiErr = n.natsTransporter.PublishSentEvent(
-							snd.Status == types.SendingConfirmed,
-							notiErrorDesc,
-							snd.Service, snd.RequestID,
-							snd.To, snd.Token, snd.Amount, snd.Digest,
-						)
-					} else {
-						logger.Warn("Nats transport is disabled, skipping notification")
-						continue
-					}
-				case types.SendingHTTP:
-					if n.httpTransporter != nil {
-						if snd.CallbackURL != "" {
-							notiErr = n.httpTransporter.PublishSentEvent(
-								snd.Status == types.SendingConfirmed,
-								notiErrorDesc,
-								snd.Service, snd.RequestID, snd.CallbackURL,
-								snd.To, snd.Token, snd.Amount, snd.Digest,
-							)
-						}
-					} else {
-						logger.Warn("HTTP transport is disabled, skipping notification")
-						continue
-					}
-				default:
-					logger.Errorf("Transport %v is not implemented", snd.Transport)
-					continue
-				}
-
-				if notiErr != nil {
-					logger.WithField("id", snd.ID).WithError(notiErr).Error("Failed to notify")
-
-					// notify next time
-					when := time.Now().UTC()
-					if snd.FirstNotifyAt != nil {
-						mikes := time.Now().UTC().Sub(*snd.FirstNotifyAt).Minutes()
-						switch {
-						// for 5m: every 1m
-						case mikes < 5:
-							when = when.Add(time.Minute)
-						// then for 30m: every 5m
-						case mikes < 35:
-							when = when.Add(time.Minute * 5)
-						// then for 60m: every 10m
-						case mikes < 95:
-							when = when.Add(time.Minute * 10)
-						// then every 120m
-						default:
-							when = when.Add(time.Minute * 120)
-						}
-					} else {
-						when = when.Add(time.Hour * 24 * 365)
-					}
-
-					// mark as unnotified
-					snd.NotifyAt = &when
-					snd.Notified = false
-					if err := n.dao.UpdateSending(snd); err != nil {
-						logger.
-							WithError(err).
-							WithField("id", snd.ID).
-							Error("Failed to update request")
-						sleep(time.Second * 30)
-						out = true
-						continue
-					}
-				} else {
-					logger.WithField("id", snd.ID).Debug("Notified")
-				}
+				snd := snd
+				pool.Submit(delivery.Job{
+					Destination: sendingDestination + snd.Service,
+					Host:        callbackHost(snd.Transport, snd.CallbackURL),
+					Run: func() bool {
+						return n.notifySending(snd, logger)
+					},
+				})
 			}
 		}
 	}()
@@ -297,4 +163,214 @@ func (n *Notifier) Task(token *gotask.Token) {
 	close(stopper)
 
 	wg.Wait()
+	pool.Stop()
+}
+
+// notifyApprovement delivers a notification, false means the rest of service's notifications should wait
+func (n *Notifier) notifyApprovement(snd *types.Approvement, logger *logrus.Entry) bool {
+
+	// lease for delivery, the request is picked up again once the lease expires without completion
+	leased, err := n.dao.LeaseApprovementNotification(snd.ID, time.Now().UTC().Add(deliveryLease))
+	if err != nil {
+		logger.
+			WithError(err).
+			WithField("id", snd.ID).
+			Error("Failed to lease request")
+		return false
+	}
+	if !leased {
+		// notified or leased meanwhile
+		return false
+	}
+	{
+		now := time.Now().UTC()
+		if snd.FirstNotifyAt == nil {
+			snd.FirstNotifyAt = &now
+		}
+		snd.NotifyAttempts++
+	}
+
+	notiErrorDesc := "Transaction failed"
+	switch snd.Status {
+	case types.SendingConfirmed:
+		notiErrorDesc = ""
+	case types.SendingCancelled:
+		notiErrorDesc = "Request cancelled"
+	}
+
+	// notify
+	var notiErr error
+	switch snd.Transport {
+	case types.SendingNats:
+		if n.natsTransporter != nil {
+			notiErr = n.natsTransporter.PublishApprovedEvent(
+				snd.Status == types.SendingConfirmed,
+				notiErrorDesc,
+				snd.Service, snd.RequestID,
+				snd.To, snd.Digest,
+				n.confirmations(snd.Status, snd.Block),
+			)
+		} else {
+			logger.Warn("Nats transport is disabled, skipping notification")
+		}
+	case types.SendingHTTP:
+		if n.httpTransporter != nil {
+			if snd.CallbackURL != "" {
+				notiErr = n.httpTransporter.PublishApprovedEvent(
+					snd.Status == types.SendingConfirmed,
+					notiErrorDesc,
+					snd.Service, snd.RequestID, snd.CallbackURL,
+					snd.To, snd.Digest,
+					n.confirmations(snd.Status, snd.Block),
+				)
+			}
+		} else {
+			logger.Warn("HTTP transport is disabled, skipping notification")
+		}
+	default:
+		logger.Errorf("Transport %v is not implemented", snd.Transport)
+	}
+
+	// notify next time or move to dead letters
+	when, alive := time.Now().UTC(), true
+	if notiErr != nil {
+		logger.
+			WithField("id", snd.ID).
+			WithField("attempt", snd.NotifyAttempts).
+			WithError(notiErr).
+			Error("Failed to notify")
+		when, alive = n.retry.Get(snd.Service).Next(*snd.FirstNotifyAt, snd.NotifyAttempts, when)
+	} else {
+		logger.WithField("id", snd.ID).Debug("Notified")
+	}
+
+	if alive {
+		// mark as notified (or unnotified) and release the lease
+		err = n.dao.CompleteApprovementNotification(snd.ID, notiErr == nil, when)
+	} else {
+		logger.WithField("id", snd.ID).Warn("Notification retries are exhausted, moved to dead letters")
+		n.alerter.LimitWarn(time.Hour, "Notifications to service %v are moved to dead letters", snd.Service)
+		err = n.dao.SetApprovementDeadLetter(snd.ID)
+	}
+	if err != nil {
+		logger.
+			WithError(err).
+			WithField("id", snd.ID).
+			Error("Failed to update request")
+		return false
+	}
+	return notiErr == nil || !alive
+}
+
+// notifySending delivers a notification, false means the rest of service's notifications should wait
+func (n *Notifier) notifySending(snd *types.Sending, logger *logrus.Entry) bool {
+
+	// lease for delivery, the request is picked up again once the lease expires without completion
+	leased, err := n.dao.LeaseSendingNotification(snd.ID, time.Now().UTC().Add(deliveryLease))
+	if err != nil {
+		logger.
+			WithError(err).
+			WithField("id", snd.ID).
+			Error("Failed to lease request")
+		return false
+	}
+	if !leased {
+		// notified or leased meanwhile
+		return false
+	}
+	{
+		now := time.Now().UTC()
+		if snd.FirstNotifyAt == nil {
+			snd.FirstNotifyAt = &now
+		}
+		snd.NotifyAttempts++
+	}
+
+	notiErrorDesc := "Transaction failed"
+	switch snd.Status {
+	case types.SendingConfirmed:
+		notiErrorDesc = ""
+	case types.SendingCancelled:
+		notiErrorDesc = "Request cancelled"
+	case types.SendingRejected:
+		notiErrorDesc = "Request rejected"
+		if snd.RejectReason != "" {
+			notiErrorDesc += ": " + snd.RejectReason
+		}
+	}
+
+	// notify
+	var notiErr error
+	switch snd.Transport {
+	case types.SendingNats:
+		if n.natsTransporter != nil {
+			notiErr = n.natsTransporter.PublishSentEvent(
+				snd.Status == types.SendingConfirmed,
+				notiErrorDesc,
+				snd.Service, snd.RequestID,
+				snd.To, snd.Token, snd.Amount, snd.Digest,
+				n.confirmations(snd.Status, snd.Block),
+			)
+		} else {
+			logger.Warn("Nats transport is disabled, skipping notification")
+		}
+	case types.SendingHTTP:
+		if n.httpTransporter != nil {
+			if snd.CallbackURL != "" {
+				notiErr = n.httpTransporter.PublishSentEvent(
+					snd.Status == types.SendingConfirmed,
+					notiErrorDesc,
+					snd.Service, snd.RequestID, snd.CallbackURL,
+					snd.To, snd.Token, snd.Amount, snd.Digest,
+					n.confirmations(snd.Status, snd.Block),
+				)
+			}
+		} else {
+			logger.Warn("HTTP transport is disabled, skipping notification")
+		}
+	default:
+		logger.Errorf("Transport %v is not implemented", snd.Transport)
+	}
+
+	// notify next time or move to dead letters
+	when, alive := time.Now().UTC(), true
+	if notiErr != nil {
+		logger.
+			WithField("id", snd.ID).
+			WithField("attempt", snd.NotifyAttempts).
+			WithError(notiErr).
+			Error("Failed to notify")
+		when, alive = n.retry.Get(snd.Service).Next(*snd.FirstNotifyAt, snd.NotifyAttempts, when)
+	} else {
+		logger.WithField("id", snd.ID).Debug("Notified")
+	}
+
+	if alive {
+		// mark as notified (or unnotified) and release the lease
+		err = n.dao.CompleteSendingNotification(snd.ID, notiErr == nil, when)
+	} else {
+		logger.WithField("id", snd.ID).Warn("Notification retries are exhausted, moved to dead letters")
+		n.alerter.LimitWarn(time.Hour, "Notifications to service %v are moved to dead letters", snd.Service)
+		err = n.dao.SetSendingDeadLetter(snd.ID)
+	}
+	if err != nil {
+		logger.
+			WithError(err).
+			WithField("id", snd.ID).
+			Error("Failed to update request")
+		return false
+	}
+	return notiErr == nil || !alive
+}
+
+// callbackHost gets a host of HTTP callback to limit concurrent deliveries
+func callbackHost(t types.SendingTransport, callbackURL string) string {
+	if t != types.SendingHTTP || callbackURL == "" {
+		return ""
+	}
+	u, err := url.Parse(callbackURL)
+	if err != nil {
+		return ""
+	}
+	return u.Host
 }
@@ -1,12 +1,17 @@
 package txconfirmer
 
 import (
+	"math/big"
 	"time"
 
+	"github.com/void616/gm.mint.sender/internal/mint/blockparser"
+	"github.com/void616/gm.mint.sender/internal/wakeup"
 	"github.com/void616/gm.mint/transaction"
 	"github.com/void616/gotask"
 )
 
+const itemsPerShot = 500
+
 // Task loop
 func (c *Confirmer) Task(token *gotask.Token) {
 
@@ -19,40 +24,179 @@ func (c *Confirmer) Task(token *gotask.Token) {
 		for !empty {
 			select {
 			case tx := <-c.in:
-				switch tx.Type {
-
-				case transaction.TransferAssetTx:
-					// save to death
-					saved := false
-					for !token.Stopped() && !saved {
-						if err := c.dao.SetSendingConfirmed(tx.Digest, tx.From, tx.Block); err != nil {
-							c.logger.WithError(err).WithField("digest", tx.Digest.String()).Errorf("Failed to confirm transaction")
-							token.Sleep(time.Second * 10)
-						} else {
-							saved = true
-							confirmedItems++
-						}
-					}
-
-				case transaction.SetWalletTagTx:
-					// save to death
-					saved := false
-					for !token.Stopped() && !saved {
-						if err := c.dao.SetApprovementConfirmed(tx.Digest, tx.From, tx.Block); err != nil {
-							c.logger.WithError(err).WithField("digest", tx.Digest.String()).Errorf("Failed to confirm transaction")
-							token.Sleep(time.Second * 10)
-						} else {
-							saved = true
-							confirmedItems++
-						}
-					}
+				if c.save(token, tx) {
+					confirmedItems++
+				}
+			case id := <-c.blockID:
+				if c.setLatestBlock(id) && c.confirmations > 0 {
+					c.promote(token)
 				}
+			case r := <-c.reorg:
+				c.rollback(token, r)
 			case <-time.After(time.Second):
 				empty = true
 			}
 		}
 		if confirmedItems > 0 {
-			c.logger.Infof("Confirmed %v transactions", confirmedItems)
+			if c.confirmations > 0 {
+				c.logger.Infof("Included %v transactions", confirmedItems)
+			} else {
+				c.logger.Infof("Confirmed %v transactions", confirmedItems)
+			}
+		}
+	}
+}
+
+// save marks transaction as confirmed or included (depending on required confirmations)
+func (c *Confirmer) save(token *gotask.Token, tx *blockparser.Transaction) bool {
+	var save func() error
+	switch tx.Type {
+	case transaction.TransferAssetTx:
+		save = func() error {
+			if c.confirmations == 0 {
+				return c.dao.SetSendingConfirmed(tx.Digest, tx.From, tx.Block)
+			}
+			return c.dao.SetSendingIncluded(tx.Digest, tx.From, tx.Block)
+		}
+	case transaction.SetWalletTagTx:
+		save = func() error {
+			if c.confirmations == 0 {
+				return c.dao.SetApprovementConfirmed(tx.Digest, tx.From, tx.Block)
+			}
+			return c.dao.SetApprovementIncluded(tx.Digest, tx.From, tx.Block)
+		}
+	default:
+		return false
+	}
+
+	// save to death
+	for !token.Stopped() {
+		if err := save(); err != nil {
+			c.logger.WithError(err).WithField("digest", tx.Digest.String()).Errorf("Failed to confirm transaction")
+			c.alerter.LimitError(time.Minute*30, "Confirmer fails to save transactions into DB")
+			token.Sleep(time.Second * 10)
+			continue
+		}
+		if c.confirmations == 0 {
+			c.wakeup.Kick(wakeup.Notification)
 		}
+		return true
 	}
+	return false
+}
+
+// promote confirms included transactions that have enough confirmations
+func (c *Confirmer) promote(token *gotask.Token) {
+	confirmedItems := 0
+
+	// sendings
+	list, err := c.dao.ListIncludedSendings(itemsPerShot)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to get included sendings")
+		return
+	}
+	for _, snd := range list {
+		if token.Stopped() {
+			return
+		}
+		if snd.Digest == nil || snd.Sender == nil || !c.deep(snd.Block) {
+			continue
+		}
+		if err := c.dao.SetSendingConfirmed(*snd.Digest, *snd.Sender, snd.Block); err != nil {
+			c.logger.WithError(err).WithField("digest", snd.Digest.String()).Errorf("Failed to confirm transaction")
+			return
+		}
+		confirmedItems++
+	}
+
+	// approvements
+	alist, err := c.dao.ListIncludedApprovements(itemsPerShot)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to get included approvements")
+		return
+	}
+	for _, apr := range alist {
+		if token.Stopped() {
+			return
+		}
+		if apr.Digest == nil || apr.Sender == nil || !c.deep(apr.Block) {
+			continue
+		}
+		if err := c.dao.SetApprovementConfirmed(*apr.Digest, *apr.Sender, apr.Block); err != nil {
+			c.logger.WithError(err).WithField("digest", apr.Digest.String()).Errorf("Failed to confirm transaction")
+			return
+		}
+		confirmedItems++
+	}
+
+	if confirmedItems > 0 {
+		c.logger.Infof("Confirmed %v transactions", confirmedItems)
+		c.wakeup.Kick(wakeup.Notification)
+	}
+}
+
+// rollback reverts transactions after the fork block and unblocks the parser
+func (c *Confirmer) rollback(token *gotask.Token, r *blockparser.Reorg) {
+	defer r.Done()
+
+	// skip transactions parsed before the reorganization, but keep ones before the fork block
+	skip := func(tx *blockparser.Transaction) {
+		if tx.Block.Cmp(r.Fork) <= 0 {
+			c.save(token, tx)
+		}
+	}
+
+	// wait for transactions still buffered in the tx filter
+	if c.checkpointOut != nil {
+		sent, passed := false, false
+		for !passed && !token.Stopped() {
+			var out chan<- *big.Int
+			if !sent {
+				out = c.checkpointOut
+			}
+			select {
+			case out <- r.Fork:
+				sent = true
+			case <-c.checkpointIn:
+				passed = sent
+			case tx := <-c.in:
+				skip(tx)
+			case <-c.blockID:
+			case <-time.After(time.Second):
+			}
+		}
+	}
+
+	// skip transactions and blocks parsed before the reorganization
+	for drained := false; !drained; {
+		select {
+		case tx := <-c.in:
+			skip(tx)
+		case <-c.blockID:
+		default:
+			drained = true
+		}
+	}
+
+	revert := func(fork *big.Int) error {
+		if err := c.dao.RevertSendings(fork); err != nil {
+			return err
+		}
+		return c.dao.RevertApprovements(fork)
+	}
+
+	// revert to death
+	for !token.Stopped() {
+		if err := revert(r.Fork); err != nil {
+			c.logger.WithError(err).WithField("fork", r.Fork.String()).Errorf("Failed to revert transactions")
+			c.alerter.LimitError(time.Minute*30, "Confirmer fails to revert transactions on DB")
+			token.Sleep(time.Second * 10)
+			continue
+		}
+		break
+	}
+
+	c.resetLatestBlock(r.Fork)
+	c.wakeup.Kick(wakeup.Signing)
+	c.logger.WithField("fork", r.Fork.String()).Warn("Transactions after the fork block are reverted")
 }
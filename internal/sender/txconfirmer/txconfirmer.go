@@ -1,28 +1,103 @@
 package txconfirmer
 
 import (
+	"math/big"
+	"sync"
+
 	"github.com/sirupsen/logrus"
+	"github.com/void616/gm.mint.sender/internal/alert"
 	"github.com/void616/gm.mint.sender/internal/mint/blockparser"
 	"github.com/void616/gm.mint.sender/internal/sender/db"
+	"github.com/void616/gm.mint.sender/internal/wakeup"
 )
 
 // Confirmer confirms sent transacions and updates them on DB
 type Confirmer struct {
-	logger *logrus.Entry
-	in     <-chan *blockparser.Transaction
-	dao    db.DAO
+	logger        *logrus.Entry
+	alerter       alert.Alerter
+	in            <-chan *blockparser.Transaction
+	blockID       <-chan *big.Int
+	reorg         <-chan *blockparser.Reorg
+	dao           db.DAO
+	confirmations uint64
+	latestLock    sync.RWMutex
+	latest        *big.Int
+	wakeup        *wakeup.Bus
+	checkpointOut chan<- *big.Int
+	checkpointIn  <-chan *big.Int
 }
 
-// New Confirmer instance
+// New Confirmer instance.
+// Transaction is confirmed immediately if confirmations is zero, otherwise it's marked as included
+// and confirmed later, when latest parsed block is `confirmations` blocks past the transaction's block.
+// On blockchain reorganization (`reorg`) transactions after the fork block are reverted to posted state
 func New(
 	in <-chan *blockparser.Transaction,
+	blockID <-chan *big.Int,
+	reorg <-chan *blockparser.Reorg,
+	latest *big.Int,
+	confirmations uint16,
 	dao db.DAO,
+	alerter alert.Alerter,
 	logger *logrus.Entry,
 ) (*Confirmer, error) {
 	f := &Confirmer{
-		logger: logger,
-		in:     in,
-		dao:    dao,
+		logger:        logger,
+		alerter:       alerter,
+		in:            in,
+		blockID:       blockID,
+		reorg:         reorg,
+		dao:           dao,
+		confirmations: uint64(confirmations),
+		latest:        new(big.Int).Set(latest),
 	}
 	return f, nil
 }
+
+// AddWakeup sets a bus to kick the notifier once transactions are confirmed and the signer once they are reverted,
+// should be called before service launch
+func (c *Confirmer) AddWakeup(b *wakeup.Bus) {
+	c.wakeup = b
+}
+
+// AddCheckpoints sets channels to pass the fork block through the tx filter on reorganization,
+// so transactions filtered before the reorganization are received before the revert.
+// Should be called before service launch
+func (c *Confirmer) AddCheckpoints(out chan<- *big.Int, in <-chan *big.Int) {
+	c.checkpointOut = out
+	c.checkpointIn = in
+}
+
+// LatestBlock returns latest parsed block ID
+func (c *Confirmer) LatestBlock() *big.Int {
+	c.latestLock.RLock()
+	defer c.latestLock.RUnlock()
+	return new(big.Int).Set(c.latest)
+}
+
+// setLatestBlock updates latest parsed block ID, returns true if the value is changed
+func (c *Confirmer) setLatestBlock(id *big.Int) bool {
+	c.latestLock.Lock()
+	defer c.latestLock.Unlock()
+	if id.Cmp(c.latest) <= 0 {
+		return false
+	}
+	c.latest.Set(id)
+	return true
+}
+
+// resetLatestBlock sets latest parsed block ID unconditionally
+func (c *Confirmer) resetLatestBlock(id *big.Int) {
+	c.latestLock.Lock()
+	defer c.latestLock.Unlock()
+	c.latest.Set(id)
+}
+
+// deep checks transaction in the block has enough confirmations
+func (c *Confirmer) deep(block *big.Int) bool {
+	if block == nil {
+		return false
+	}
+	depth := new(big.Int).Sub(c.LatestBlock(), block)
+	return depth.Cmp(new(big.Int).SetUint64(c.confirmations)) >= 0
+}
@@ -3,10 +3,13 @@ package txsigner
 import (
 	"fmt"
 	"math/big"
+	"time"
 
 	mint "github.com/void616/gm.mint"
 	"github.com/void616/gm.mint.rpc/request"
 	"github.com/void616/gm.mint.sender/internal/sender/db/types"
+	"github.com/void616/gm.mint.sender/internal/sender/keys"
+	"github.com/void616/gm.mint.sender/internal/wakeup"
 	"github.com/void616/gm.mint/transaction"
 )
 
@@ -24,6 +27,7 @@ func (s *Signer) processApprovingRequest(apv *types.Approvement, currentBlock *b
 		p, err := s.pickApprovementSigner()
 		if err != nil {
 			logger.WithError(err).Errorf("Failed to pick signer")
+			s.alerter.LimitError(time.Hour, "Authority signer is not found")
 			return false
 		}
 		sigpub = p
@@ -53,7 +57,7 @@ func (s *Signer) processApprovingRequest(apv *types.Approvement, currentBlock *b
 		Address: apv.To,
 		Tag:     mint.WalletTagApproved,
 	}
-	stx, err := tatx.Sign(signer.signer, nonce)
+	stx, err := keys.SignSetWalletTag(signer.signer, nonce, &tatx)
 	if err != nil {
 		logger.WithError(err).Errorf("Failed to sign transaction")
 		return false
@@ -81,7 +85,19 @@ func (s *Signer) processApprovingRequest(apv *types.Approvement, currentBlock *b
 	apv.Digest = &mint.Digest{}
 	*apv.Digest = stx.Digest
 	apv.SentAtBlock = new(big.Int).Set(currentBlock)
-	if err := s.dao.UpdateApprovement(apv); err != nil {
+	if freshNonce {
+		ok, err := s.dao.SetApprovementPosted(apv)
+		if err != nil {
+			logger.WithError(err).Errorf("Failed to mark request posted")
+			return false
+		}
+		// cancelled by requestor
+		if !ok {
+			logger.Infof("Request is not enqueued anymore, skipping")
+			signer.nonce--
+			return false
+		}
+	} else if err := s.dao.UpdateApprovement(apv); err != nil {
 		logger.WithError(err).Errorf("Failed to mark request posted")
 		return false
 	}
@@ -93,7 +109,9 @@ func (s *Signer) processApprovingRequest(apv *types.Approvement, currentBlock *b
 			apv.Status = types.SendingFailed
 			if err := s.dao.UpdateApprovement(apv); err != nil {
 				logger.WithError(err).Errorf("Failed to mark request failed")
+				return
 			}
+			s.wakeup.Kick(wakeup.Notification)
 		}
 	}()
 
@@ -109,6 +127,9 @@ func (s *Signer) processApprovingRequest(apv *types.Approvement, currentBlock *b
 
 	if rerr != nil {
 		logger.WithError(rerr.Err()).Errorf("Sending failed")
+		if ncode, _, ok := rerr.GetReason(); ok && (ncode.TxNonceAhead() || ncode.TxNonceBehind()) {
+			s.resyncRequired = true
+		}
 		reject = true
 		return false
 	}
@@ -123,7 +144,7 @@ func (s *Signer) pickApprovementSigner() (mint.PublicKey, error) {
 
 	sorted := make([]mint.PublicKey, 0)
 	for _, v := range s.signers {
-		if v.approver {
+		if v.approver && !v.draining {
 			sorted = append(sorted, v.public)
 		}
 	}
@@ -0,0 +1,110 @@
+package txsigner
+
+import (
+	"time"
+
+	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint/amount"
+	"github.com/void616/gm.mint/fee"
+)
+
+// LowBalance describes signers balances monitoring
+type LowBalance struct {
+	// Default threshold of a signer
+	Default Threshold
+	// Thresholds by signer (overrides default)
+	Thresholds map[mint.PublicKey]Threshold
+	// Nats delivers low balance event via Nats (optional)
+	Nats NatsTransporter
+	// HTTP delivers low balance event to CallbackURL (optional)
+	HTTP        HTTPTransporter
+	CallbackURL string
+}
+
+// Threshold of a signer balance, nil value disables the check for the token
+type Threshold struct {
+	Gold *amount.Amount
+	MNT  *amount.Amount
+}
+
+// NatsTransporter delivers low balance event via Nats or fail with an error
+type NatsTransporter interface {
+	PublishLowBalanceEvent(signer mint.PublicKey, t mint.Token, balance, threshold *amount.Amount) error
+}
+
+// HTTPTransporter delivers low balance event via HTTP or fail with an error
+type HTTPTransporter interface {
+	PublishLowBalanceEvent(url string, signer mint.PublicKey, t mint.Token, balance, threshold *amount.Amount) error
+}
+
+// AddLowBalance adds signers balances monitoring and should be called before service launch
+func (s *Signer) AddLowBalance(lb *LowBalance) {
+	s.lowBalance = lb
+}
+
+// checkBalance notifies once the signer's balance falls below the threshold
+func (s *Signer) checkBalance(sig *SignerData) {
+	if sig.emitter || sig.draining || s.lowBalance == nil {
+		return
+	}
+	th, ok := s.lowBalance.Thresholds[sig.public]
+	if !ok {
+		th = s.lowBalance.Default
+	}
+
+	check := func(t mint.Token, balance, threshold *amount.Amount, low *bool) {
+		if threshold == nil {
+			return
+		}
+		if balance.Value.Cmp(threshold.Value) >= 0 {
+			*low = false
+			return
+		}
+		if *low {
+			return
+		}
+
+		s.logger.
+			WithField("balance", balance.String()).
+			WithField("threshold", threshold.String()).
+			Warnf("Signer %v is low on %v", sig.public.StringMask(), t.String())
+		s.alerter.LimitWarn(time.Hour, "Signer %v is low on %v", sig.public.StringMask(), t.String())
+
+		// notify, retry on next check in case of failure
+		*low = s.notifyLowBalance(sig.public, t, balance, threshold)
+	}
+
+	check(mint.TokenGOLD, sig.gold, th.Gold, &sig.lowGold)
+	check(mint.TokenMNT, sig.mnt, th.MNT, &sig.lowMnt)
+}
+
+// spend reduces the signer's balance by the amount of the sent token and the transaction fee
+func spend(sig *SignerData, t mint.Token, a *amount.Amount) {
+	sub := amount.FromAmount(a)
+	switch t {
+	case mint.TokenGOLD:
+		sub.Value.Add(sub.Value, fee.GoldFee(sub, sig.mnt).Value)
+		sig.gold.Value.Sub(sig.gold.Value, sub.Value)
+	case mint.TokenMNT:
+		sub.Value.Add(sub.Value, fee.MntFee(sub).Value)
+		sig.mnt.Value.Sub(sig.mnt.Value, sub.Value)
+	}
+}
+
+// notifyLowBalance sends low balance event via configured transports
+func (s *Signer) notifyLowBalance(pub mint.PublicKey, t mint.Token, balance, threshold *amount.Amount) bool {
+	ok := true
+	if s.lowBalance.Nats != nil {
+		if err := s.lowBalance.Nats.PublishLowBalanceEvent(pub, t, balance, threshold); err != nil {
+			s.logger.WithError(err).Error("Failed to publish low balance event via Nats")
+			ok = false
+		}
+	}
+	if s.lowBalance.HTTP != nil && s.lowBalance.CallbackURL != "" {
+		if err := s.lowBalance.HTTP.PublishLowBalanceEvent(s.lowBalance.CallbackURL, pub, t, balance, threshold); err != nil {
+			s.logger.WithError(err).Error("Failed to publish low balance event via HTTP")
+			ok = false
+		}
+	}
+	return ok
+}
@@ -0,0 +1,87 @@
+package txsigner
+
+import (
+	"time"
+
+	"github.com/void616/gm.mint.rpc/request"
+	"github.com/void616/gm.mint/amount"
+)
+
+// resync reloads signers balances and nonces from the network and detects nonce drift
+func (s *Signer) resync() bool {
+	ctx, conn, cls, err := s.pool.Conn()
+	if err != nil {
+		s.logger.WithError(err).Error("Failed to get RPC connection")
+		return false
+	}
+	defer cls()
+
+	for _, sig := range s.signers {
+		logger := s.logger.WithField("signer", sig.public.StringMask())
+
+		ws, rerr, err := request.GetWalletState(ctx, conn, sig.public)
+		if err != nil || rerr != nil {
+			if rerr != nil {
+				err = rerr.Err()
+			}
+			logger.WithError(err).Error("Failed to get signer wallet state")
+			return false
+		}
+
+		dbnonce, err := s.dao.LatestSenderNonce(sig.public)
+		if err != nil {
+			logger.WithError(err).Error("Failed to get signer latest nonce")
+			return false
+		}
+
+		// transactions are sent by someone else using the same key
+		if ws.LastTransactionID > dbnonce {
+			logger.
+				WithField("net_nonce", ws.LastTransactionID).
+				WithField("db_nonce", dbnonce).
+				Warn("Network nonce is ahead of the DB nonce")
+			s.alerter.LimitWarn(time.Hour, "Signer %v is used outside of the service", sig.public.StringMask())
+		}
+
+		// use the greatest
+		nonce := ws.LastTransactionID
+		if dbnonce > nonce {
+			nonce = dbnonce
+		}
+		if sig.nonce != nonce {
+			logger.
+				WithField("net_nonce", ws.LastTransactionID).
+				WithField("db_nonce", dbnonce).
+				WithField("nonce", sig.nonce).
+				Warnf("Signer nonce drift, nonce is reset to %v", nonce)
+			s.alerter.LimitWarn(time.Hour, "Signer %v nonce drift detected", sig.public.StringMask())
+			sig.nonce = nonce
+		}
+
+		if sig.emitter {
+			continue
+		}
+
+		// posted transactions are not applied by the network yet
+		posted, err := s.dao.ListPostedSendings(sig.public, ws.LastTransactionID)
+		if err != nil {
+			logger.WithError(err).Error("Failed to get signer posted sendings")
+			return false
+		}
+
+		sig.gold = amount.FromAmount(ws.Balance.Gold)
+		sig.mnt = amount.FromAmount(ws.Balance.Mnt)
+		for _, snd := range posted {
+			spend(sig, snd.Token, snd.Amount)
+		}
+
+		// metrics
+		if s.metrics != nil {
+			s.metrics.Balance.WithLabelValues(sig.public.String(), "gold").Set(sig.gold.Float64())
+			s.metrics.Balance.WithLabelValues(sig.public.String(), "mnt").Set(sig.mnt.Float64())
+		}
+
+		s.checkBalance(sig)
+	}
+	return true
+}
@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"math/big"
 	"sort"
+	"time"
 
 	mint "github.com/void616/gm.mint"
 	"github.com/void616/gm.mint.rpc/request"
 	"github.com/void616/gm.mint.sender/internal/sender/db/types"
+	"github.com/void616/gm.mint.sender/internal/sender/keys"
+	"github.com/void616/gm.mint.sender/internal/wakeup"
 	"github.com/void616/gm.mint/amount"
 	"github.com/void616/gm.mint/fee"
 	"github.com/void616/gm.mint/transaction"
@@ -60,6 +63,7 @@ func (s *Signer) processSendingRequest(snd *types.Sending, currentBlock *big.Int
 		p, err := s.pickSendingSigner(snd.Amount, snd.Token, snd.IgnoreApprovement)
 		if err != nil {
 			logger.WithError(err).Errorf("Failed to pick signer")
+			s.alerter.LimitError(time.Hour, "All signers are out of %v or failed", snd.Token.String())
 			return false
 		}
 		sigpub = p
@@ -90,7 +94,7 @@ func (s *Signer) processSendingRequest(snd *types.Sending, currentBlock *big.Int
 		Token:   snd.Token,
 		Amount:  snd.Amount,
 	}
-	stx, err := tatx.Sign(signer.signer, nonce)
+	stx, err := keys.SignTransferAsset(signer.signer, nonce, &tatx)
 	if err != nil {
 		logger.WithError(err).Errorf("Failed to sign transaction")
 		return false
@@ -104,21 +108,15 @@ func (s *Signer) processSendingRequest(snd *types.Sending, currentBlock *big.Int
 		if !signer.emitter {
 			defer func() {
 				if posted {
-					sub := amount.FromAmount(snd.Amount)
-					switch snd.Token {
-					case mint.TokenGOLD:
-						sub.Value.Add(sub.Value, fee.GoldFee(sub, signer.mnt).Value)
-						signer.gold.Value.Sub(signer.gold.Value, sub.Value)
-					case mint.TokenMNT:
-						sub.Value.Add(sub.Value, fee.MntFee(sub).Value)
-						signer.mnt.Value.Sub(signer.mnt.Value, sub.Value)
-					}
+					spend(signer, snd.Token, snd.Amount)
 
 					// metrics
 					if s.metrics != nil {
 						s.metrics.Balance.WithLabelValues(signer.public.String(), "gold").Set(signer.gold.Float64())
 						s.metrics.Balance.WithLabelValues(signer.public.String(), "mnt").Set(signer.mnt.Float64())
 					}
+
+					s.checkBalance(signer)
 				}
 			}()
 		}
@@ -141,7 +139,19 @@ func (s *Signer) processSendingRequest(snd *types.Sending, currentBlock *big.Int
 	snd.Digest = &mint.Digest{}
 	*snd.Digest = stx.Digest
 	snd.SentAtBlock = new(big.Int).Set(currentBlock)
-	if err := s.dao.UpdateSending(snd); err != nil {
+	if freshNonce {
+		ok, err := s.dao.SetSendingPosted(snd)
+		if err != nil {
+			logger.WithError(err).Errorf("Failed to mark request posted")
+			return false
+		}
+		// cancelled by requestor
+		if !ok {
+			logger.Infof("Request is not enqueued anymore, skipping")
+			signer.nonce--
+			return false
+		}
+	} else if err := s.dao.UpdateSending(snd); err != nil {
 		logger.WithError(err).Errorf("Failed to mark request posted")
 		return false
 	}
@@ -153,7 +163,9 @@ func (s *Signer) processSendingRequest(snd *types.Sending, currentBlock *big.Int
 			snd.Status = types.SendingFailed
 			if err := s.dao.UpdateSending(snd); err != nil {
 				logger.WithError(err).Errorf("Failed to mark request failed")
+				return
 			}
+			s.wakeup.Kick(wakeup.Notification)
 		}
 	}()
 
@@ -185,9 +197,11 @@ func (s *Signer) processSendingRequest(snd *types.Sending, currentBlock *big.Int
 			return false
 		case ncode.TxNonceAhead():
 			logger.Errorf("Node replied with: nonce ahead")
+			s.resyncRequired = true
 			// not matter, keep posting it
 		case ncode.TxNonceBehind():
 			logger.Errorf("Node replied with: nonce behind (duplicate)")
+			s.resyncRequired = true
 			// reject it in case it's a fresh tx
 			if freshNonce {
 				reject = true
@@ -220,6 +234,11 @@ func (s *Signer) pickSendingSigner(a *amount.Amount, t mint.Token, emitterRequir
 	for _, pub := range sorted {
 		v := s.signers[pub]
 
+		// draining signer doesn't take fresh requests
+		if v.draining {
+			continue
+		}
+
 		// emitter required
 		if emitterRequired && !v.emitter {
 			continue
@@ -1,33 +1,47 @@
 package txsigner
 
 import (
-	"strconv"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	mint "github.com/void616/gm.mint"
-	"github.com/void616/gm.mint.rpc/request"
+	"github.com/void616/gm.mint.sender/internal/alert"
 	"github.com/void616/gm.mint.sender/internal/mint/rpcpool"
 	"github.com/void616/gm.mint.sender/internal/sender/db"
+	"github.com/void616/gm.mint.sender/internal/sender/keys"
+	"github.com/void616/gm.mint.sender/internal/wakeup"
 	"github.com/void616/gm.mint/amount"
-	"github.com/void616/gm.mint/signer"
 )
 
 const itemsPerShot = 25
 const staleAfterBlocks = 1
 
+// defaultResyncInterval is a default period of signers state reloading
+const defaultResyncInterval = time.Minute * 5
+
+// unreachableAlertAfter is a period of node unavailability to raise an alert
+const unreachableAlertAfter = time.Minute * 5
+
 // Signer signs and sends transactions
 type Signer struct {
 	logger  *logrus.Entry
+	alerter alert.Alerter
 	pool    *rpcpool.Pool
 	signers map[mint.PublicKey]*SignerData
 	dao     db.DAO
 	metrics *Metrics
+
+	lowBalance     *LowBalance
+	resyncInterval time.Duration
+	resyncRequired bool
+	update         chan []keys.Signer
+	wakeup         *wakeup.Bus
 }
 
 // SignerData describes particular signer
 type SignerData struct {
-	signer      *signer.Signer
+	signer      keys.Signer
 	public      mint.PublicKey
 	nonce       uint64
 	gold        *amount.Amount
@@ -35,13 +49,20 @@ type SignerData struct {
 	emitter     bool
 	approver    bool
 	signedCount uint64
+	lowGold     bool
+	lowMnt      bool
+	// draining signer only reposts it's stale transactions
+	draining bool
 }
 
-// New Signer instance
+// New Signer instance.
+// Signers' balances and nonces are reloaded from the network every `resync` (default is used if zero)
 func New(
 	pool *rpcpool.Pool,
 	dao db.DAO,
-	signers []*signer.Signer,
+	signers []keys.Signer,
+	resync time.Duration,
+	alerter alert.Alerter,
 	logger *logrus.Entry,
 ) (*Signer, error) {
 
@@ -55,71 +76,26 @@ func New(
 	// make a map of signers with some extra data required in runtime
 	signerz := make(map[mint.PublicKey]*SignerData)
 	for _, ss := range signers {
-		pubkey := ss.PublicKey()
-
-		// get wallet state from the network
-		walletState, rerr, err := request.GetWalletState(ctx, conn, pubkey)
-		if err != nil {
-			return nil, err
-		}
-		if rerr != nil {
-			return nil, rerr.Err()
-		}
-		emitter := false
-		for _, t := range walletState.Tags {
-			if t == mint.WalletTagEmission.String() {
-				emitter = true
-				break
-			}
-		}
-		approver := false
-		for _, t := range walletState.Tags {
-			if t == mint.WalletTagAuthority.String() {
-				approver = true
-				break
-			}
-		}
-
-		// check db for a greater nonce
-		dbnonce, err := dao.LatestSenderNonce(pubkey)
+		sd, err := prepareSigner(ctx, conn, dao, ss, logger)
 		if err != nil {
 			return nil, err
 		}
+		signerz[sd.public] = sd
+	}
 
-		// use the greatest
-		nonce := walletState.LastTransactionID
-		if dbnonce > nonce {
-			nonce = dbnonce
-		}
-
-		signerz[pubkey] = &SignerData{
-			signer:      ss,
-			public:      pubkey,
-			nonce:       nonce,
-			gold:        amount.FromAmount(walletState.Balance.Gold),
-			mnt:         amount.FromAmount(walletState.Balance.Mnt),
-			emitter:     emitter,
-			approver:    approver,
-			signedCount: 0,
-		}
-
-		logGold, logMnt := strconv.FormatFloat(walletState.Balance.Gold.Float64(), 'f', 6, 64), strconv.FormatFloat(walletState.Balance.Mnt.Float64(), 'f', 6, 64)
-		if emitter {
-			logGold, logMnt = "emitter", "emitter"
-		}
-		logger.
-			WithField("net_nonce", walletState.LastTransactionID).
-			WithField("db_nonce", dbnonce).
-			WithField("gold", logGold).
-			WithField("mnt", logMnt).
-			Infof("Signer %v prepared", pubkey.StringMask())
+	if resync == 0 {
+		resync = defaultResyncInterval
 	}
 
 	s := &Signer{
 		logger:  logger,
+		alerter: alerter,
 		dao:     dao,
 		pool:    pool,
 		signers: signerz,
+		update:  make(chan []keys.Signer, 1),
+
+		resyncInterval: resync,
 	}
 	return s, nil
 }
@@ -142,3 +118,9 @@ func (s *Signer) AddMetrics(m *Metrics) {
 		}
 	}
 }
+
+// AddWakeup sets a bus to pick up enqueued requests immediately and kick the notifier once requests fail,
+// should be called before service launch
+func (s *Signer) AddWakeup(b *wakeup.Bus) {
+	s.wakeup = b
+}
@@ -0,0 +1,136 @@
+package txsigner
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/sirupsen/logrus"
+	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.rpc/conn"
+	"github.com/void616/gm.mint.rpc/request"
+	"github.com/void616/gm.mint.sender/internal/sender/db"
+	"github.com/void616/gm.mint.sender/internal/sender/keys"
+	"github.com/void616/gm.mint/amount"
+)
+
+// UpdateSigners replaces a set of signers in runtime.
+// New signers are prepared and used for fresh requests, missing signers are marked as draining: they only repost their stale transactions
+func (s *Signer) UpdateSigners(signers []keys.Signer) {
+	// keep the latest set only
+	select {
+	case <-s.update:
+	default:
+	}
+	s.update <- signers
+}
+
+// applyUpdate adds new signers and marks missing ones as draining
+func (s *Signer) applyUpdate(signers []keys.Signer) bool {
+	ctx, conn, cls, err := s.pool.Conn()
+	if err != nil {
+		s.logger.WithError(err).Error("Failed to get RPC connection")
+		return false
+	}
+	defer cls()
+
+	keep := make(map[mint.PublicKey]struct{})
+	for _, ss := range signers {
+		pub := ss.PublicKey()
+		keep[pub] = struct{}{}
+
+		if sd, ok := s.signers[pub]; ok {
+			if sd.draining {
+				sd.draining = false
+				s.logger.Infof("Signer %v is not draining anymore", pub.StringMask())
+			}
+			continue
+		}
+
+		sd, err := prepareSigner(ctx, conn, s.dao, ss, s.logger)
+		if err != nil {
+			s.logger.WithError(err).Errorf("Failed to prepare signer %v", pub.StringMask())
+			return false
+		}
+		s.signers[pub] = sd
+
+		// metrics
+		if s.metrics != nil {
+			s.metrics.Balance.WithLabelValues(pub.String(), "gold").Set(sd.gold.Float64())
+			s.metrics.Balance.WithLabelValues(pub.String(), "mnt").Set(sd.mnt.Float64())
+		}
+
+		s.checkBalance(sd)
+	}
+
+	for pub, sd := range s.signers {
+		if _, ok := keep[pub]; !ok && !sd.draining {
+			sd.draining = true
+			s.logger.Infof("Signer %v is draining", pub.StringMask())
+		}
+	}
+	return true
+}
+
+// prepareSigner gets signer state from the network and DB
+func prepareSigner(ctx context.Context, conn *conn.Conn, dao db.DAO, ss keys.Signer, logger *logrus.Entry) (*SignerData, error) {
+	pubkey := ss.PublicKey()
+
+	// get wallet state from the network
+	walletState, rerr, err := request.GetWalletState(ctx, conn, pubkey)
+	if err != nil {
+		return nil, err
+	}
+	if rerr != nil {
+		return nil, rerr.Err()
+	}
+	emitter := false
+	for _, t := range walletState.Tags {
+		if t == mint.WalletTagEmission.String() {
+			emitter = true
+			break
+		}
+	}
+	approver := false
+	for _, t := range walletState.Tags {
+		if t == mint.WalletTagAuthority.String() {
+			approver = true
+			break
+		}
+	}
+
+	// check db for a greater nonce
+	dbnonce, err := dao.LatestSenderNonce(pubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	// use the greatest
+	nonce := walletState.LastTransactionID
+	if dbnonce > nonce {
+		nonce = dbnonce
+	}
+
+	sd := &SignerData{
+		signer:      ss,
+		public:      pubkey,
+		nonce:       nonce,
+		gold:        amount.FromAmount(walletState.Balance.Gold),
+		mnt:         amount.FromAmount(walletState.Balance.Mnt),
+		emitter:     emitter,
+		approver:    approver,
+		signedCount: 0,
+	}
+
+	logGold, logMnt := strconv.FormatFloat(walletState.Balance.Gold.Float64(), 'f', 6, 64), strconv.FormatFloat(walletState.Balance.Mnt.Float64(), 'f', 6, 64)
+	if emitter {
+		logGold, logMnt = "emitter", "emitter"
+	}
+	logger.
+		WithField("net_nonce", walletState.LastTransactionID).
+		WithField("db_nonce", dbnonce).
+		WithField("gold", logGold).
+		WithField("mnt", logMnt).
+		Infof("Signer %v prepared", pubkey.StringMask())
+
+	return sd, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/void616/gm.mint.rpc/request"
 	"github.com/void616/gm.mint.sender/internal/sender/db/types"
+	"github.com/void616/gm.mint.sender/internal/wakeup"
 	"github.com/void616/gotask"
 )
 
@@ -15,15 +16,38 @@ func (s *Signer) Task(token *gotask.Token) {
 	requests := make(chan interface{}, itemsPerShot*4)
 	defer close(requests)
 
+	kicked := s.wakeup.Subscribe(wakeup.Signing)
+
 	currentBlock := new(big.Int)
+	reachedAt := time.Now()
+	syncedAt := time.Now()
+
+	// balances are fresh at the moment
+	for _, sig := range s.signers {
+		s.checkBalance(sig)
+	}
 
 	for !token.Stopped() {
 
+		// apply signers update
+		select {
+		case signers := <-s.update:
+			if !s.applyUpdate(signers) {
+				// retry next time unless there is a newer update
+				select {
+				case s.update <- signers:
+				default:
+				}
+			}
+		default:
+		}
+
 		// get current network block
 		{
 			ctx, conn, cls, err := s.pool.Conn()
 			if err != nil {
 				s.logger.WithError(err).Error("Failed to get RPC connection")
+				s.alertUnreachable(reachedAt)
 				token.Sleep(time.Second * 30)
 				continue
 			}
@@ -35,14 +59,24 @@ func (s *Signer) Task(token *gotask.Token) {
 					err = rerr.Err()
 				}
 				s.logger.WithError(err).Error("Failed to get current block ID")
+				s.alertUnreachable(reachedAt)
 				token.Sleep(time.Second * 30)
 				continue
 			}
 
 			currentBlock.Sub(state.BlockCount.Int, big.NewInt(1))
+			reachedAt = time.Now()
 			cls()
 		}
 
+		// reload signers state
+		if s.resyncRequired || time.Since(syncedAt) >= s.resyncInterval {
+			if s.resync() {
+				syncedAt = time.Now()
+				s.resyncRequired = false
+			}
+		}
+
 		count := 0
 
 		// get stale requests (approvements)
@@ -119,7 +153,7 @@ func (s *Signer) Task(token *gotask.Token) {
 
 		// empty queue
 		if count == 0 {
-			token.Sleep(time.Second * 10)
+			wakeup.Sleep(token, kicked, time.Second*10)
 			continue
 		}
 
@@ -154,7 +188,14 @@ func (s *Signer) Task(token *gotask.Token) {
 		}
 
 		if processed == 0 {
-			token.Sleep(time.Second * 30)
+			wakeup.Sleep(token, kicked, time.Second*30)
 		}
 	}
 }
+
+// alertUnreachable raises an alert if the node is unreachable since `reachedAt` for too long
+func (s *Signer) alertUnreachable(reachedAt time.Time) {
+	if time.Since(reachedAt) >= unreachableAlertAfter {
+		s.alerter.LimitError(time.Minute*30, "Signer can't reach Mint node for more than %v", unreachableAlertAfter)
+	}
+}
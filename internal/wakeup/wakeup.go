@@ -0,0 +1,81 @@
+// Package wakeup is an in-process signal bus to pick up fresh work immediately, polling remains a safety net
+package wakeup
+
+import (
+	"sync"
+	"time"
+
+	"github.com/void616/gotask"
+)
+
+// Topic of signals
+type Topic uint8
+
+const (
+	// Signing means requests are enqueued to sign and post
+	Signing Topic = iota
+	// Notification means notifications are ready to deliver
+	Notification
+)
+
+// Bus of signals, nil bus is valid and never signals
+type Bus struct {
+	lock sync.Mutex
+	subs map[Topic][]chan struct{}
+}
+
+// New Bus instance
+func New() *Bus {
+	return &Bus{
+		subs: make(map[Topic][]chan struct{}),
+	}
+}
+
+// Subscribe to the topic, pending signals are merged into one
+func (b *Bus) Subscribe(t Topic) <-chan struct{} {
+	if b == nil {
+		return nil
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	c := make(chan struct{}, 1)
+	b.subs[t] = append(b.subs[t], c)
+	return c
+}
+
+// Kick subscribers of the topic
+func (b *Bus) Kick(t Topic) {
+	if b == nil {
+		return
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for _, c := range b.subs[t] {
+		select {
+		case c <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Sleep sleeps specified amount of time or until a signal is received or the task is requested to stop.
+// Returns `true` if the task is requested to stop
+func Sleep(token *gotask.Token, c <-chan struct{}, d time.Duration) bool {
+	deadline := time.Now().Add(d)
+	for !token.Stopped() {
+		left := time.Until(deadline)
+		if left <= 0 {
+			return false
+		}
+		// token doesn't expose a channel, so check it every second
+		if left > time.Second {
+			left = time.Second
+		}
+		select {
+		case <-c:
+			return false
+		case <-time.After(left):
+		}
+	}
+	return true
+}
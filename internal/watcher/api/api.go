@@ -3,6 +3,7 @@ package api
 import (
 	"github.com/sirupsen/logrus"
 	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/wakeup"
 	"github.com/void616/gm.mint.sender/internal/watcher/api/model"
 	"github.com/void616/gm.mint.sender/internal/watcher/db"
 )
@@ -13,6 +14,7 @@ type API struct {
 	watchWallet chan<- mint.PublicKey
 	walletSubs  chan<- model.WalletSub
 	dao         db.DAO
+	wakeup      *wakeup.Bus
 }
 
 // New instance
@@ -30,3 +32,8 @@ func New(
 	}
 	return f, nil
 }
+
+// AddWakeup sets a bus to kick the notifier once notifications are redelivered and should be called before service launch
+func (api *API) AddWakeup(b *wakeup.Bus) {
+	api.wakeup = b
+}
@@ -0,0 +1,51 @@
+package api
+
+import (
+	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/wakeup"
+	"github.com/void616/gm.mint.sender/internal/watcher/db/types"
+)
+
+// ListDeadIncomings gets a list of incomings with notification in dead letters (empty service means any service)
+func (api *API) ListDeadIncomings(service string, max uint16) ([]*types.Incoming, bool) {
+	var serviceID uint64
+	if service != "" {
+		s, err := api.dao.GetService(service)
+		if err != nil {
+			api.logger.WithError(err).Error("Failed to get service")
+			return nil, false
+		}
+		if s == nil {
+			return []*types.Incoming{}, true
+		}
+		serviceID = s.ID
+	}
+	list, err := api.dao.ListDeadIncomings(serviceID, max)
+	if err != nil {
+		api.logger.WithError(err).Error("Failed to get dead incomings")
+		return nil, false
+	}
+	return list, true
+}
+
+// RedeliverIncomings moves notifications of the service transaction from dead letters back to delivery (redelivered is false if there are no such notifications in dead letters)
+func (api *API) RedeliverIncomings(service string, digest mint.Digest) (redelivered, success bool) {
+	s, err := api.dao.GetService(service)
+	if err != nil {
+		api.logger.WithError(err).Error("Failed to get service")
+		return false, false
+	}
+	if s == nil {
+		return false, true
+	}
+	ok, err := api.dao.RedeliverIncomings(s.ID, digest)
+	if err != nil {
+		api.logger.WithError(err).Error("Failed to redeliver incomings")
+		return false, false
+	}
+	if ok {
+		api.logger.WithField("service", service).WithField("tx", digest.String()).Info("Transaction notification is redelivered")
+		api.wakeup.Kick(wakeup.Notification)
+	}
+	return ok, true
+}
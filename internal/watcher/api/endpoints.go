@@ -4,10 +4,12 @@ import (
 	mint "github.com/void616/gm.mint"
 	"github.com/void616/gm.mint.sender/internal/watcher/api/model"
 	"github.com/void616/gm.mint.sender/internal/watcher/db/types"
+	"github.com/void616/gm.mint/transaction"
 )
 
-// AddWallet adds wallet to the DB and sends it to the transaction filter
-func (api *API) AddWallet(trans types.ServiceTransport, service, callbackURL string, pub ...mint.PublicKey) bool {
+// AddWallet adds wallet to the DB and sends it to the transaction filter.
+// Existing subscription of the service is updated with the new direction and transaction types
+func (api *API) AddWallet(trans types.ServiceTransport, service, callbackURL string, dir types.Direction, txTypes []transaction.Code, pub ...mint.PublicKey) bool {
 
 	if err := api.dao.PutService(&types.Service{
 		Name:        service,
@@ -33,6 +35,8 @@ func (api *API) AddWallet(trans types.ServiceTransport, service, callbackURL str
 		list[i] = &types.Wallet{
 			PublicKey: v,
 			Service:   *s,
+			Direction: dir,
+			Types:     txTypes,
 		}
 	}
 
@@ -44,6 +48,8 @@ func (api *API) AddWallet(trans types.ServiceTransport, service, callbackURL str
 		api.walletSubs <- model.WalletSub{
 			PublicKey: p,
 			Service:   *s,
+			Direction: dir,
+			Types:     txTypes,
 			Add:       true,
 		}
 		api.watchWallet <- p
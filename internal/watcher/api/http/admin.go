@@ -0,0 +1,175 @@
+package http
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	gohttp "net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/watcher/api/model"
+	pkg "github.com/void616/gm.mint.sender/pkg/watcher/http"
+)
+
+// AddAdmins enables administrators endpoints (tokens maps bearer token to administrator name) and should be called before service launch
+func (h *HTTP) AddAdmins(tokens map[string]string) {
+	h.admins = tokens
+}
+
+// authAdmin gets administrator name by the request bearer token or empty string
+func (h *HTTP) authAdmin(r *gohttp.Request) string {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") {
+		return ""
+	}
+	token := []byte(strings.TrimPrefix(auth, "Bearer "))
+
+	name := ""
+	for t, n := range h.admins {
+		if subtle.ConstantTimeCompare([]byte(t), token) == 1 {
+			name = n
+		}
+	}
+	return name
+}
+
+// adminDead is GET method to list transactions with notification in dead letters (optional service query parameter)
+func (h *HTTP) adminDead(w gohttp.ResponseWriter, r *gohttp.Request) {
+	defer r.Body.Close()
+
+	// metrics
+	if h.metrics != nil {
+		defer func(t time.Time) {
+			h.metrics.RequestDuration.WithLabelValues("admin_dead").Observe(time.Since(t).Seconds())
+		}(time.Now())
+	}
+
+	// reply
+	var res = pkg.DeadResponse{}
+	var status = gohttp.StatusBadRequest
+
+	defer func() {
+		b, err := json.Marshal(&res)
+		if err != nil {
+			h.logger.WithError(err).Error("Failed to marshal response")
+			w.WriteHeader(gohttp.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(status)
+		w.Write(b)
+	}()
+
+	// auth
+	if h.authAdmin(r) == "" {
+		res.Error = "unauthorized"
+		status = gohttp.StatusUnauthorized
+		return
+	}
+
+	// check req service
+	reqService := r.URL.Query().Get("service")
+	if reqService != "" && !model.ServiceNameRex.MatchString(reqService) {
+		res.Error = "invalid service name"
+		return
+	}
+
+	// get
+	list, ok := h.api.ListDeadIncomings(reqService, model.MaxDeadItems)
+	if !ok {
+		res.Error = "internal failure"
+		status = gohttp.StatusInternalServerError
+		return
+	}
+
+	// success
+	res.Success = true
+	res.Items = make([]pkg.DeadItem, len(list))
+	for i, inc := range list {
+		first := ""
+		if inc.FirstNotifyAt != nil {
+			first = inc.FirstNotifyAt.UTC().Format(time.RFC3339)
+		}
+		res.Items[i] = pkg.DeadItem{
+			Service:       inc.Service.Name,
+			PublicKey:     inc.Wallet.String(),
+			Transaction:   inc.Digest.String(),
+			Attempts:      inc.NotifyAttempts,
+			FirstNotifyAt: first,
+		}
+	}
+	status = gohttp.StatusOK
+}
+
+// adminRedeliver is POST method to redeliver notifications of the service transaction from dead letters
+func (h *HTTP) adminRedeliver(w gohttp.ResponseWriter, r *gohttp.Request) {
+	defer r.Body.Close()
+
+	// metrics
+	if h.metrics != nil {
+		defer func(t time.Time) {
+			h.metrics.RequestDuration.WithLabelValues("admin_redeliver").Observe(time.Since(t).Seconds())
+		}(time.Now())
+	}
+
+	vars := mux.Vars(r)
+	reqService, reqTx := vars["service"], vars["transaction"]
+
+	h.logger.WithField("data", reqService+":"+reqTx).Debug("Got redelivery request")
+
+	// reply
+	var res = struct {
+		Success bool   `json:"success"`
+		Error   string `json:"error,omitempty"`
+		Status  int    `json:"-"`
+	}{false, "", gohttp.StatusBadRequest}
+
+	defer func() {
+		b, err := json.Marshal(&res)
+		if err != nil {
+			h.logger.WithError(err).Error("Failed to marshal response")
+			w.WriteHeader(gohttp.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(res.Status)
+		w.Write(b)
+	}()
+
+	// auth
+	if h.authAdmin(r) == "" {
+		res.Error = "unauthorized"
+		res.Status = gohttp.StatusUnauthorized
+		return
+	}
+
+	// check req service
+	if !model.ServiceNameRex.MatchString(reqService) {
+		res.Error = "invalid service name"
+		return
+	}
+
+	// unpack base58
+	digest, err := mint.ParseDigest(reqTx)
+	if err != nil {
+		res.Error = "invalid transaction digest"
+		return
+	}
+
+	// redeliver
+	redelivered, ok := h.api.RedeliverIncomings(reqService, digest)
+	switch {
+	case !ok:
+		res.Error = "internal failure"
+		res.Status = gohttp.StatusInternalServerError
+		return
+	case !redelivered:
+		res.Error = "notification is not in dead letters"
+		res.Status = gohttp.StatusNotFound
+		return
+	}
+
+	// success
+	res.Success = true
+	res.Status = gohttp.StatusOK
+}
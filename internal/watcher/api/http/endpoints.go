@@ -25,6 +25,7 @@ func (h *HTTP) watch(w gohttp.ResponseWriter, r *gohttp.Request) {
 
 	// parse
 	req := pkg.WatchRequest{}
+	var body []byte
 	{
 		b, err := ioutil.ReadAll(r.Body)
 		if err != nil {
@@ -35,6 +36,7 @@ func (h *HTTP) watch(w gohttp.ResponseWriter, r *gohttp.Request) {
 			h.logger.WithError(err).Error("Failed to unmarshal request")
 			return
 		}
+		body = b
 	}
 
 	h.logger.WithField("data", len(req.PublicKeys)).Debug("Got watch request")
@@ -63,6 +65,13 @@ func (h *HTTP) watch(w gohttp.ResponseWriter, r *gohttp.Request) {
 		return
 	}
 
+	// authenticate
+	if !h.authenticate(r, req.Service, body) {
+		res.Error = "unauthorized"
+		res.Status = gohttp.StatusUnauthorized
+		return
+	}
+
 	// unpack base58
 	pubs := make([]mint.PublicKey, 0)
 	for _, p := range req.PublicKeys {
@@ -84,8 +93,20 @@ func (h *HTTP) watch(w gohttp.ResponseWriter, r *gohttp.Request) {
 		return
 	}
 
+	// parse subscription
+	dir, ok := types.ParseDirection(req.Direction)
+	if !ok {
+		res.Error = "invalid direction"
+		return
+	}
+	txTypes, ok := types.ParseTypes(req.Types)
+	if !ok {
+		res.Error = "invalid transaction types"
+		return
+	}
+
 	// add to ROI
-	if !h.api.AddWallet(types.ServiceHTTP, req.Service, req.Callback, pubs...) {
+	if !h.api.AddWallet(types.ServiceHTTP, req.Service, req.Callback, dir, txTypes, pubs...) {
 		res.Error = "internal failure"
 		res.Status = gohttp.StatusInternalServerError
 		return
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"crypto/tls"
 	"fmt"
 	gohttp "net/http"
 	"sync"
@@ -10,7 +11,9 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/auth"
 	"github.com/void616/gm.mint.sender/internal/watcher/db/types"
+	"github.com/void616/gm.mint/transaction"
 	"github.com/void616/gotask"
 )
 
@@ -20,12 +23,16 @@ type HTTP struct {
 	api     API
 	server  *gohttp.Server
 	metrics *Metrics
+	auth    *auth.Keys
+	admins  map[string]string
 }
 
 // API provides API methods
 type API interface {
-	AddWallet(trans types.ServiceTransport, service, callbackURL string, p ...mint.PublicKey) bool
+	AddWallet(trans types.ServiceTransport, service, callbackURL string, dir types.Direction, txTypes []transaction.Code, p ...mint.PublicKey) bool
 	RemoveWallet(service string, p ...mint.PublicKey) bool
+	ListDeadIncomings(service string, max uint16) ([]*types.Incoming, bool)
+	RedeliverIncomings(service string, digest mint.Digest) (redelivered, success bool)
 }
 
 // New instance
@@ -53,6 +60,8 @@ func New(
 
 	r.Path("/watch").Methods("POST").HandlerFunc(h.watch)
 	r.Path("/unwatch").Methods("POST").HandlerFunc(h.unwatch)
+	r.Path("/admin/dead").Methods("GET").HandlerFunc(h.adminDead)
+	r.Path("/admin/dead/{service}/{transaction}/redeliver").Methods("POST").HandlerFunc(h.adminRedeliver)
 
 	logger.Infof("HTTP transport enabled on port %v", port)
 	return h, nil
@@ -69,6 +78,11 @@ func (h *HTTP) AddMetrics(m *Metrics) {
 	h.metrics = m
 }
 
+// AddTLS serves HTTPS with the config (see secure.ServerTLS) and should be called before service launch
+func (h *HTTP) AddTLS(c *tls.Config) {
+	h.server.TLSConfig = c
+}
+
 // Task loop
 func (h *HTTP) Task(token *gotask.Token) {
 
@@ -78,7 +92,13 @@ func (h *HTTP) Task(token *gotask.Token) {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		if err := h.server.ListenAndServe(); err != nil && err != gohttp.ErrServerClosed {
+		var err error
+		if h.server.TLSConfig != nil {
+			err = h.server.ListenAndServeTLS("", "")
+		} else {
+			err = h.server.ListenAndServe()
+		}
+		if err != nil && err != gohttp.ErrServerClosed {
 			h.logger.WithError(err).Error("Failed to listen and serve")
 			token.Stop()
 		}
@@ -8,8 +8,10 @@ import (
 	"time"
 
 	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/watcher/db/types"
 	pkg "github.com/void616/gm.mint.sender/pkg/watcher/http"
 	"github.com/void616/gm.mint/amount"
+	"github.com/void616/gm.mint/transaction"
 )
 
 // NotifyRefilling sends a notification
@@ -35,31 +37,70 @@ func (h *HTTP) NotifyRefilling(url, service string, to, from mint.PublicKey, t m
 		return err
 	}
 
-	// http request
-	{
-		timeoutSec := 10
-		transport := &http.Transport{
-			IdleConnTimeout: time.Second * time.Duration(timeoutSec),
-		}
-		client := &http.Client{
-			Timeout:   time.Second * time.Duration(timeoutSec),
-			Transport: transport,
-		}
-		req, err := http.NewRequest("POST", url, bytes.NewBuffer(b))
-		if err != nil {
-			return err
-		}
-		req.Header.Set("Content-Type", "application/json; charset=utf-8")
-		resp, err := client.Do(req)
-		if err != nil {
-			return err
-		}
-		defer resp.Body.Close()
+	return h.postCallback(url, service, b)
+}
 
-		if resp.StatusCode != 200 {
-			return fmt.Errorf("callback status code is %v", resp.StatusCode)
-		}
+// NotifyTransaction sends a notification
+func (h *HTTP) NotifyTransaction(url, service string, inc *types.Incoming) error {
+	// metrics
+	if h.metrics != nil {
+		defer func(t time.Time) {
+			h.metrics.NotificationDuration.Observe(time.Since(t).Seconds())
+		}(time.Now())
 	}
 
+	event := pkg.TransactionEvent{
+		Service:     service,
+		PublicKey:   inc.Wallet.String(),
+		Direction:   "in",
+		Type:        inc.Type.String(),
+		Data:        inc.Data,
+		Transaction: inc.Digest.String(),
+	}
+	if c := inc.Counterparty(); c != (mint.PublicKey{}) {
+		event.Counterparty = c.String()
+	}
+	if inc.Outgoing {
+		event.Direction = "out"
+	}
+	if inc.Type == transaction.TransferAssetTx {
+		event.Token = inc.Token.String()
+		event.Amount = inc.Amount.String()
+	}
+
+	b, err := json.Marshal(&event)
+	if err != nil {
+		return err
+	}
+	return h.postCallback(url, service, b)
+}
+
+// postCallback posts JSON to the callback URL, body is signed with the service secret in case authentication is enabled
+func (h *HTTP) postCallback(url, service string, b []byte) error {
+	timeoutSec := 10
+	transport := &http.Transport{
+		IdleConnTimeout: time.Second * time.Duration(timeoutSec),
+	}
+	client := &http.Client{
+		Timeout:   time.Second * time.Duration(timeoutSec),
+		Transport: transport,
+	}
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	if h.auth != nil {
+		h.auth.SignCallback(req, service, b)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("callback status code is %v", resp.StatusCode)
+	}
 	return nil
 }
@@ -9,6 +9,9 @@ import (
 // ServiceNameRex is service name pattern
 var ServiceNameRex = regexp.MustCompile("^[a-z0-9_]{1,64}$")
 
+// MaxDeadItems is max number of items within a dead letters list
+const MaxDeadItems = 1000
+
 // ValidCallback checker
 func ValidCallback(s string) bool {
 	u, err := url.Parse(s)
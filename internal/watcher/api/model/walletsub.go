@@ -3,11 +3,14 @@ package model
 import (
 	"github.com/void616/gm.mint.sender/internal/watcher/db/types"
 	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint/transaction"
 )
 
 // WalletSub contains data to add/remove a pair wallet:service to transaction saver
 type WalletSub struct {
 	PublicKey mint.PublicKey
 	Service   types.Service
+	Direction types.Direction
+	Types     []transaction.Code
 	Add       bool
 }
@@ -7,8 +7,10 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/auth"
 	"github.com/void616/gm.mint.sender/internal/watcher/db/types"
 	walletNats "github.com/void616/gm.mint.sender/pkg/watcher/nats"
+	"github.com/void616/gm.mint/transaction"
 	"github.com/void616/gotask"
 )
 
@@ -19,26 +21,33 @@ type Nats struct {
 	api            API
 	natsConnection *gonats.Conn
 	metrics        *Metrics
+	auth           *auth.Keys
 }
 
 // API provides API methods
 type API interface {
-	AddWallet(serviceTrans types.ServiceTransport, service string, callbackURL string, p ...mint.PublicKey) bool
+	AddWallet(serviceTrans types.ServiceTransport, service string, callbackURL string, dir types.Direction, txTypes []transaction.Code, p ...mint.PublicKey) bool
 	RemoveWallet(service string, p ...mint.PublicKey) bool
+	ListDeadIncomings(service string, max uint16) ([]*types.Incoming, bool)
+	RedeliverIncomings(service string, digest mint.Digest) (redelivered, success bool)
 }
 
 // New instance
 func New(
 	url string,
 	subjPrefix string,
+	opts []gonats.Option,
 	api API,
 	logger *logrus.Entry,
 ) (*Nats, func(), error) {
 
+	// opts provide TLS and credentials (see secure.Nats)
 	natsConnection, err := gonats.Connect(
 		url,
-		gonats.Name("mint_watcher"),
-		gonats.MaxReconnects(-1),
+		append([]gonats.Option{
+			gonats.Name("mint_watcher"),
+			gonats.MaxReconnects(-1),
+		}, opts...)...,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -84,6 +93,20 @@ func (n *Nats) Task(token *gotask.Token) {
 		n.logger.WithError(err).Errorf("Failed to subscribe to %v", subj)
 	}
 
+	// sub for dead letters requests
+	subj = n.subjPrefix + walletNats.DeadLetters{}.Subject()
+	_, err = nc.Subscribe(subj, n.subDeadLetters)
+	if err != nil {
+		n.logger.WithError(err).Errorf("Failed to subscribe to %v", subj)
+	}
+
+	// sub for redelivery requests
+	subj = n.subjPrefix + walletNats.Redeliver{}.Subject()
+	_, err = nc.Subscribe(subj, n.subRedeliver)
+	if err != nil {
+		n.logger.WithError(err).Errorf("Failed to subscribe to %v", subj)
+	}
+
 	// wait
 	for !token.Stopped() {
 		token.Sleep(time.Millisecond * 500)
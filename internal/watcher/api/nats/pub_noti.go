@@ -6,8 +6,10 @@ import (
 
 	proto "github.com/golang/protobuf/proto"
 	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/watcher/db/types"
 	walletsvc "github.com/void616/gm.mint.sender/pkg/watcher/nats"
 	"github.com/void616/gm.mint/amount"
+	"github.com/void616/gm.mint/transaction"
 )
 
 // NotifyRefilling sends an event
@@ -50,3 +52,54 @@ func (n *Nats) NotifyRefilling(service string, to, from mint.PublicKey, t mint.T
 
 	return nil
 }
+
+// NotifyTransaction sends an event
+func (n *Nats) NotifyTransaction(service string, inc *types.Incoming) error {
+
+	// metrics
+	if n.metrics != nil {
+		defer func(t time.Time) {
+			n.metrics.NotificationDuration.Observe(time.Since(t).Seconds())
+		}(time.Now())
+	}
+
+	reqModel := &walletsvc.Transaction{
+		Service:     service,
+		PublicKey:   inc.Wallet.String(),
+		Direction:   "in",
+		Type:        inc.Type.String(),
+		Data:        inc.Data,
+		Transaction: inc.Digest.String(),
+	}
+	if c := inc.Counterparty(); c != (mint.PublicKey{}) {
+		reqModel.Counterparty = c.String()
+	}
+	if inc.Outgoing {
+		reqModel.Direction = "out"
+	}
+	if inc.Type == transaction.TransferAssetTx {
+		reqModel.Token = inc.Token.String()
+		reqModel.Amount = inc.Amount.String()
+	}
+
+	req, err := proto.Marshal(reqModel)
+	if err != nil {
+		return err
+	}
+
+	msg, err := n.natsConnection.Request(n.subjPrefix+walletsvc.Transaction{}.Subject(), req, time.Second*5)
+	if err != nil {
+		return err
+	}
+
+	repModel := walletsvc.TransactionAck{}
+	if err := proto.Unmarshal(msg.Data, &repModel); err != nil {
+		return err
+	}
+
+	if !repModel.GetSuccess() {
+		return fmt.Errorf("service rejection: %v", repModel.GetError())
+	}
+
+	return nil
+}
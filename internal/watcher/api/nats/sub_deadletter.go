@@ -0,0 +1,132 @@
+package nats
+
+import (
+	"time"
+
+	proto "github.com/golang/protobuf/proto"
+	gonats "github.com/nats-io/nats.go"
+	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/watcher/api/model"
+	walletNats "github.com/void616/gm.mint.sender/pkg/watcher/nats"
+)
+
+// subDeadLetters processes Nats request to list the service transactions with notification in dead letters
+func (n *Nats) subDeadLetters(m *gonats.Msg) {
+	nc := n.natsConnection
+
+	// metrics
+	if n.metrics != nil {
+		defer func(t time.Time) {
+			n.metrics.RequestDuration.WithLabelValues("deadletters").Observe(time.Since(t).Seconds())
+		}(time.Now())
+	}
+
+	// parse
+	req := walletNats.DeadLetters{}
+	if err := proto.Unmarshal(m.Data, &req); err != nil {
+		n.logger.WithError(err).Error("Failed to unmarshal request")
+		return
+	}
+
+	n.logger.WithField("data", req.GetService()).Debug("Got dead letters request")
+
+	// reply
+	var rep = walletNats.DeadLettersReply{}
+	defer func() {
+		rep.Success = rep.Error == ""
+		if b, err := proto.Marshal(&rep); err != nil {
+			n.logger.WithError(err).Error("Failed to marshal reply")
+		} else {
+			if err := nc.Publish(m.Reply, b); err != nil {
+				n.logger.WithError(err).Error("Failed to publish reply")
+			}
+		}
+	}()
+
+	// check req service
+	if !model.ServiceNameRex.MatchString(req.GetService()) {
+		rep.Error = "invalid service name"
+		return
+	}
+
+	// authenticate
+	if !n.authenticateQuery(req.GetService(), req.GetTimestamp(), &req.Signature, &req) {
+		rep.Error = "unauthorized"
+		return
+	}
+
+	// get
+	list, ok := n.api.ListDeadIncomings(req.GetService(), model.MaxDeadItems)
+	if !ok {
+		rep.Error = "internal failure"
+		return
+	}
+	for _, inc := range list {
+		rep.Items = append(rep.Items, &walletNats.DeadLetter{
+			PublicKey:   inc.Wallet.String(),
+			Transaction: inc.Digest.String(),
+		})
+	}
+}
+
+// subRedeliver processes Nats request to redeliver notifications of the service transaction from dead letters
+func (n *Nats) subRedeliver(m *gonats.Msg) {
+	nc := n.natsConnection
+
+	// metrics
+	if n.metrics != nil {
+		defer func(t time.Time) {
+			n.metrics.RequestDuration.WithLabelValues("redeliver").Observe(time.Since(t).Seconds())
+		}(time.Now())
+	}
+
+	// parse
+	req := walletNats.Redeliver{}
+	if err := proto.Unmarshal(m.Data, &req); err != nil {
+		n.logger.WithError(err).Error("Failed to unmarshal request")
+		return
+	}
+
+	n.logger.WithField("data", req.GetService()+":"+req.GetTransaction()).Debug("Got redelivery request")
+
+	// reply
+	var rep = walletNats.RedeliverReply{}
+	defer func() {
+		rep.Success = rep.Error == ""
+		if b, err := proto.Marshal(&rep); err != nil {
+			n.logger.WithError(err).Error("Failed to marshal reply")
+		} else {
+			if err := nc.Publish(m.Reply, b); err != nil {
+				n.logger.WithError(err).Error("Failed to publish reply")
+			}
+		}
+	}()
+
+	// check req service
+	if !model.ServiceNameRex.MatchString(req.GetService()) {
+		rep.Error = "invalid service name"
+		return
+	}
+
+	// authenticate
+	if !n.authenticate(req.GetService(), req.GetTimestamp(), &req.Signature, &req) {
+		rep.Error = "unauthorized"
+		return
+	}
+
+	// unpack base58
+	digest, err := mint.ParseDigest(req.GetTransaction())
+	if err != nil {
+		rep.Error = "invalid transaction digest"
+		return
+	}
+
+	// redeliver
+	redelivered, ok := n.api.RedeliverIncomings(req.GetService(), digest)
+	switch {
+	case !ok:
+		rep.Error = "internal failure"
+	case !redelivered:
+		rep.Error = "notification is not in dead letters"
+	}
+}
@@ -53,6 +53,12 @@ func (n *Nats) subAddRemoveWallet(m *gonats.Msg) {
 		return
 	}
 
+	// authenticate
+	if !n.authenticate(req.GetService(), req.GetTimestamp(), &req.Signature, &req) {
+		replyError = "unauthorized"
+		return
+	}
+
 	// unpack base58
 	pubs := make([]mint.PublicKey, 0)
 	for _, p := range req.GetPublicKey() {
@@ -64,7 +70,17 @@ func (n *Nats) subAddRemoveWallet(m *gonats.Msg) {
 		pubs = append(pubs, pub)
 	}
 	if req.GetAdd() {
-		if !n.api.AddWallet(types.ServiceNats, req.GetService(), "", pubs...) {
+		dir, ok := types.ParseDirection(req.GetDirection())
+		if !ok {
+			replyError = "invalid direction"
+			return
+		}
+		txTypes, ok := types.ParseTypes(req.GetTypes())
+		if !ok {
+			replyError = "invalid transaction types"
+			return
+		}
+		if !n.api.AddWallet(types.ServiceNats, req.GetService(), "", dir, txTypes, pubs...) {
 			replyError = "internal failure"
 			return
 		}
@@ -1,6 +1,10 @@
 package db
 
 import (
+	"math/big"
+	"time"
+
+	mint "github.com/void616/gm.mint"
 	"github.com/void616/gm.mint.sender/internal/watcher/db/types"
 )
 
@@ -18,10 +22,22 @@ type DAO interface {
 	GetService(name string) (*types.Service, error)
 
 	PutWallet(v ...*types.Wallet) error
-	ListWallets() ([]*types.WalletServices, error)
+	ListWallets() ([]*types.Wallet, error)
 	DeleteWallet(v ...*types.Wallet) error
 
 	PutIncoming(v ...*types.Incoming) error
-	ListUnnotifiedIncomings(max uint16) ([]*types.Incoming, error)
+	ListUnnotifiedIncomings(max uint16, skip []uint64) ([]*types.Incoming, error)
 	UpdateIncoming(v *types.Incoming) error
+	// LeaseIncomingNotification leases unnotified incoming for notification delivery until the time and counts delivery attempt, returns false if the incoming is notified or leased already
+	LeaseIncomingNotification(id uint64, until time.Time) (bool, error)
+	// CompleteIncomingNotification releases the lease of the incoming and marks it as notified or schedules next delivery attempt
+	CompleteIncomingNotification(id uint64, notified bool, notifyAt time.Time) error
+	// SetIncomingDeadLetter releases the lease of the incoming and moves it's notification to dead letters (retries are exhausted)
+	SetIncomingDeadLetter(id uint64) error
+	// ListDeadIncomings gets a list of incomings with notification in dead letters (zero service ID means any service)
+	ListDeadIncomings(serviceID uint64, max uint16) ([]*types.Incoming, error)
+	// RedeliverIncomings moves notifications of the service transaction from dead letters back to delivery, returns false if there are no such incomings in dead letters
+	RedeliverIncomings(serviceID uint64, digest mint.Digest) (bool, error)
+	// DeleteIncomings deletes incomings after the fork block (blockchain reorganization)
+	DeleteIncomings(fork *big.Int) error
 }
@@ -1,13 +1,9 @@
 package mysql
 
 import (
-	"fmt"
-	"strconv"
-	"strings"
 
 	"github.com/void616/gm.mint.sender/internal/watcher/db/mysql/model"
 	"github.com/void616/gm.mint.sender/internal/watcher/db/types"
-	mint "github.com/void616/gm.mint"
 )
 
 // PutWallet implementation
@@ -32,6 +28,16 @@ func (d *Database) PutWallet(v ...*types.Wallet) error {
 			if !d.DuplicateError(err) {
 				return err
 			}
+			// update subscription
+			if err := tx.Model(&model.Wallet{}).
+				Where("`public_key`=? AND `service_id`=?", m.PublicKey, m.Service.ID).
+				Updates(map[string]interface{}{
+					"direction": m.Direction,
+					"types":     m.Types,
+				}).
+				Error; err != nil {
+				return err
+			}
 		}
 	}
 	txok = true
@@ -39,51 +45,18 @@ func (d *Database) PutWallet(v ...*types.Wallet) error {
 }
 
 // ListWallets implementation
-func (d *Database) ListWallets() ([]*types.WalletServices, error) {
-	services := make([]*model.Service, 0)
-	if err := d.Model(&model.Service{}).Find(&services).Error; err != nil {
-		return nil, err
-	}
-	mlist := make([]struct {
-		PublicKey []byte
-		ServiceID string
-	}, 0)
-	table := d.NewScope(&model.Wallet{}).QuotedTableName()
-	if err := d.Raw("SELECT `public_key`, GROUP_CONCAT(`service_id` SEPARATOR '|') AS `service_id` FROM " + table + " GROUP BY `public_key`").Scan(&mlist).Error; err != nil {
+func (d *Database) ListWallets() ([]*types.Wallet, error) {
+	mlist := make([]*model.Wallet, 0)
+	if err := d.Preload("Service").Find(&mlist).Error; err != nil {
 		return nil, err
 	}
-	list := make([]*types.WalletServices, len(mlist))
+	list := make([]*types.Wallet, len(mlist))
 	for i, m := range mlist {
-		pub, err := mint.BytesToPublicKey(m.PublicKey)
+		w, err := m.MapTo()
 		if err != nil {
 			return nil, err
 		}
-		svcs := make([]types.Service, 0)
-		for _, idstr := range strings.Split(m.ServiceID, "|") {
-			id, err := strconv.ParseUint(strings.TrimSpace(idstr), 10, 64)
-			if err != nil {
-				return nil, err
-			}
-			found := false
-			for _, svc := range services {
-				if svc.ID == id {
-					msvc, err := svc.MapTo()
-					if err != nil {
-						return nil, err
-					}
-					svcs = append(svcs, *msvc)
-					found = true
-					break
-				}
-			}
-			if !found {
-				return nil, fmt.Errorf("failed to find service #%v", id)
-			}
-		}
-		list[i] = &types.WalletServices{
-			PublicKey: pub,
-			Services:  svcs,
-		}
+		list[i] = w
 	}
 	return list, nil
 }
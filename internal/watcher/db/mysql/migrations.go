@@ -34,4 +34,70 @@ var migrations = []*gormigrate.Migration{
 				Error
 		},
 	},
+
+	// subscriptions: direction and transaction types
+	{
+		ID: "2026-10-18T09:12:41.205Z",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.
+				AutoMigrate(&model.Wallet{}).
+				AutoMigrate(&model.Incoming{}).
+				Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return nil
+		},
+	},
+	// incomings: notification delivery lease and attempts
+	{
+		ID: "2026-10-18T12:47:33.581Z",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.
+				AutoMigrate(&model.Incoming{}).
+				Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return nil
+		},
+	},
+	// incomings: notification dead letters
+	{
+		ID: "2026-10-18T13:58:16.730Z",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.
+				AutoMigrate(&model.Incoming{}).
+				Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return nil
+		},
+	},
+	// incomings: watching wallet, from/to are transaction parties
+	{
+		ID: "2026-10-18T15:22:47.619Z",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&model.Incoming{}).Error; err != nil {
+				return err
+			}
+			// "to" used to be the watching wallet, it's swapped with "from" for outgoing transactions
+			if err := tx.Model(&model.Incoming{}).UpdateColumn("wallet", gorm.Expr("`to`")).Error; err != nil {
+				return err
+			}
+			outgoing := tx.Model(&model.Incoming{}).Where("`outgoing`=?", true)
+			if err := outgoing.UpdateColumn("to", gorm.Expr("`from`")).Error; err != nil {
+				return err
+			}
+			if err := outgoing.UpdateColumn("from", gorm.Expr("`wallet`")).Error; err != nil {
+				return err
+			}
+			return tx.
+				Model(&model.Incoming{}).
+				RemoveIndex("ux_watcher_incomings_svcidtodigest").
+				AddUniqueIndex("ux_watcher_incomings_svcidwalletdigest", "service_id", "wallet", "digest").
+				Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return nil
+		},
+	},
 }
@@ -8,23 +8,31 @@ import (
 	"github.com/void616/gm.mint.sender/internal/watcher/db/types"
 	mint "github.com/void616/gm.mint"
 	"github.com/void616/gm.mint/amount"
+	"github.com/void616/gm.mint/transaction"
 )
 
 // Incoming model
 type Incoming struct {
-	ID            uint64 `gorm:"PRIMARY_KEY;AUTO_INCREMENT:true;NOT NULL"`
-	ServiceID     uint64 `gorm:"NOT NULL"`
-	Service       Service
-	To            []byte     `gorm:"SIZE:32;NOT NULL"`
-	From          []byte     `gorm:"SIZE:32;NOT NULL"`
-	Amount        string     `gorm:"NOT NULL" sql:"TYPE:decimal(30,18)"`
-	Token         uint16     `gorm:"NOT NULL"`
-	Digest        []byte     `gorm:"SIZE:32;NOT NULL"`
-	Block         []byte     `gorm:"SIZE:32;NOT NULL"`
-	Timestamp     time.Time  `gorm:"NOT NULL;DEFAULT:current_timestamp"`
-	FirstNotifyAt *time.Time `gorm:""`
-	NotifyAt      *time.Time `gorm:""`
-	Notified      bool       `gorm:"NOT NULL"`
+	ID              uint64 `gorm:"PRIMARY_KEY;AUTO_INCREMENT:true;NOT NULL"`
+	ServiceID       uint64 `gorm:"NOT NULL"`
+	Service         Service
+	Wallet          []byte     `gorm:"SIZE:32;NOT NULL;DEFAULT:''"`
+	To              []byte     `gorm:"SIZE:32;NOT NULL"`
+	From            []byte     `gorm:"SIZE:32;NOT NULL"`
+	Outgoing        bool       `gorm:"NOT NULL;DEFAULT:false"`
+	Type            uint16     `gorm:"NOT NULL;DEFAULT:10"`
+	Data            []byte     `gorm:""`
+	Amount          string     `gorm:"NOT NULL" sql:"TYPE:decimal(30,18)"`
+	Token           uint16     `gorm:"NOT NULL"`
+	Digest          []byte     `gorm:"SIZE:32;NOT NULL"`
+	Block           []byte     `gorm:"SIZE:32;NOT NULL"`
+	Timestamp       time.Time  `gorm:"NOT NULL;DEFAULT:current_timestamp"`
+	FirstNotifyAt   *time.Time `gorm:""`
+	NotifyAt        *time.Time `gorm:""`
+	Notified        bool       `gorm:"NOT NULL"`
+	NotifyAttempts  uint32     `gorm:"NOT NULL;DEFAULT:0"`
+	DeliveringUntil *time.Time `gorm:""`
+	DeadLetter      bool       `gorm:"NOT NULL;DEFAULT:false"`
 }
 
 // MapFrom mapping
@@ -35,8 +43,12 @@ func (i *Incoming) MapFrom(t *types.Incoming) error {
 	}
 	i.ID = t.ID
 	i.Service = svc
+	i.Wallet = t.Wallet.Bytes()
 	i.To = t.To.Bytes()
 	i.From = t.From.Bytes()
+	i.Outgoing = t.Outgoing
+	i.Type = uint16(t.Type)
+	i.Data = t.Data
 	i.Amount = t.Amount.String()
 	i.Token = uint16(t.Token)
 	i.Digest = t.Digest.Bytes()
@@ -45,6 +57,9 @@ func (i *Incoming) MapFrom(t *types.Incoming) error {
 	i.FirstNotifyAt = t.FirstNotifyAt
 	i.NotifyAt = t.NotifyAt
 	i.Notified = t.Notified
+	i.NotifyAttempts = t.NotifyAttempts
+	i.DeliveringUntil = t.DeliveringUntil
+	i.DeadLetter = t.DeadLetter
 	return nil
 }
 
@@ -54,6 +69,10 @@ func (i *Incoming) MapTo() (*types.Incoming, error) {
 	if err != nil {
 		return nil, err
 	}
+	wallet, err := mint.BytesToPublicKey(i.Wallet)
+	if err != nil {
+		return nil, fmt.Errorf("invalid wallet")
+	}
 	to, err := mint.BytesToPublicKey(i.To)
 	if err != nil {
 		return nil, fmt.Errorf("invalid to")
@@ -73,17 +92,24 @@ func (i *Incoming) MapTo() (*types.Incoming, error) {
 	block := new(big.Int).SetBytes(i.Block)
 
 	return &types.Incoming{
-		ID:            i.ID,
-		Service:       *svc,
-		To:            to,
-		From:          from,
-		Amount:        amo,
-		Token:         mint.Token(i.Token),
-		Digest:        digest,
-		Block:         block,
-		Timestamp:     i.Timestamp,
-		FirstNotifyAt: i.FirstNotifyAt,
-		NotifyAt:      i.NotifyAt,
-		Notified:      i.Notified,
+		ID:              i.ID,
+		Service:         *svc,
+		Wallet:          wallet,
+		To:              to,
+		From:            from,
+		Outgoing:        i.Outgoing,
+		Type:            transaction.Code(i.Type),
+		Data:            i.Data,
+		Amount:          amo,
+		Token:           mint.Token(i.Token),
+		Digest:          digest,
+		Block:           block,
+		Timestamp:       i.Timestamp,
+		FirstNotifyAt:   i.FirstNotifyAt,
+		NotifyAt:        i.NotifyAt,
+		Notified:        i.Notified,
+		NotifyAttempts:  i.NotifyAttempts,
+		DeliveringUntil: i.DeliveringUntil,
+		DeadLetter:      i.DeadLetter,
 	}, nil
 }
@@ -0,0 +1,189 @@
+package postgres
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/watcher/db/postgres/model"
+	"github.com/void616/gm.mint.sender/internal/watcher/db/types"
+)
+
+// PutIncoming implementation
+func (d *Database) PutIncoming(v ...*types.Incoming) error {
+	mlist := make([]*model.Incoming, 0)
+	for _, w := range v {
+		m := &model.Incoming{}
+		if err := m.MapFrom(w); err != nil {
+			return err
+		}
+		mlist = append(mlist, m)
+	}
+	tx := d.Begin()
+	txok := false
+	defer func() {
+		if !txok {
+			tx.Rollback()
+		}
+	}()
+	for _, m := range mlist {
+		if _, err := d.createSkipDuplicate(tx, m); err != nil {
+			return err
+		}
+	}
+	txok = true
+	return tx.Commit().Error
+}
+
+// UpdateIncoming implementation
+func (d *Database) UpdateIncoming(v *types.Incoming) error {
+	var m = &model.Incoming{}
+	if err := m.MapFrom(v); err != nil {
+		return err
+	}
+	return d.Save(m).Error
+}
+
+// LeaseIncomingNotification implementation
+func (d *Database) LeaseIncomingNotification(id uint64, until time.Time) (bool, error) {
+	now := time.Now().UTC()
+	res := d.Model(&model.Incoming{}).
+		Where(`"id"=? AND "notified"=false AND "dead_letter"=false AND ("delivering_until" IS NULL OR "delivering_until"<=?)`, id, now).
+		Update(
+			map[string]interface{}{
+				"delivering_until": until.UTC(),
+				"notify_attempts":  gorm.Expr(`"notify_attempts"+1`),
+				"first_notify_at":  gorm.Expr(`COALESCE("first_notify_at",?)`, now),
+			},
+		)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// CompleteIncomingNotification implementation
+func (d *Database) CompleteIncomingNotification(id uint64, notified bool, notifyAt time.Time) error {
+	return d.Model(&model.Incoming{}).
+		Where(`"id"=?`, id).
+		Update(
+			map[string]interface{}{
+				"notified":         notified,
+				"notify_at":        notifyAt.UTC(),
+				"delivering_until": nil,
+			},
+		).
+		Error
+}
+
+// SetIncomingDeadLetter implementation
+func (d *Database) SetIncomingDeadLetter(id uint64) error {
+	return d.Model(&model.Incoming{}).
+		Where(`"id"=?`, id).
+		Update(
+			map[string]interface{}{
+				"dead_letter":      true,
+				"delivering_until": nil,
+			},
+		).
+		Error
+}
+
+// ListDeadIncomings implementation
+func (d *Database) ListDeadIncomings(serviceID uint64, max uint16) ([]*types.Incoming, error) {
+	m := make([]*model.Incoming, 0)
+
+	db := d.
+		Model(&model.Incoming{}).
+		Preload("Service").
+		Where(`"dead_letter"=true AND "notified"=false`)
+	if serviceID != 0 {
+		db = db.Where(`"service_id"=?`, serviceID)
+	}
+	res := db.
+		Order(`"id" ASC`).
+		Limit(max).
+		Find(&m)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	list := make([]*types.Incoming, len(m))
+	for i, v := range m {
+		s, err := v.MapTo()
+		if err != nil {
+			return nil, err
+		}
+		list[i] = s
+	}
+	return list, nil
+}
+
+// RedeliverIncomings implementation
+func (d *Database) RedeliverIncomings(serviceID uint64, digest mint.Digest) (bool, error) {
+	res := d.Model(&model.Incoming{}).
+		Where(`"service_id"=? AND "digest"=? AND "dead_letter"=true AND "notified"=false`, serviceID, digest.Bytes()).
+		Update(
+			map[string]interface{}{
+				"dead_letter":     false,
+				"notify_attempts": 0,
+				"first_notify_at": nil,
+				"notify_at":       time.Now().UTC(),
+			},
+		)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// DeleteIncomings implementation
+func (d *Database) DeleteIncomings(fork *big.Int) error {
+	b := fork.Bytes()
+	return d.
+		Where(`LENGTH("block")>? OR (LENGTH("block")=? AND "block">?)`, len(b), len(b), b).
+		Delete(&model.Incoming{}).
+		Error
+}
+
+// ListUnnotifiedIncomings implementation
+func (d *Database) ListUnnotifiedIncomings(max uint16, skip []uint64) ([]*types.Incoming, error) {
+	m := make([]*model.Incoming, 0)
+
+	q := d.
+		Model(&model.Incoming{}).
+		Preload("Service").
+		Where(
+			`"notified"=false AND ("notify_at" IS NULL OR "notify_at"<=?) AND "dead_letter"=false AND ("delivering_until" IS NULL OR "delivering_until"<=?)`,
+			time.Now().UTC(),
+			time.Now().UTC(),
+		).
+		Where(
+			// keep the order: earlier notification of the destination awaits a retry or is being delivered
+			`NOT EXISTS (SELECT 1 FROM "`+d.tablePrefix+`incomings" AS "p" WHERE "p"."service_id"="`+d.tablePrefix+`incomings"."service_id" AND "p"."id"<"`+d.tablePrefix+`incomings"."id" AND "p"."notified"=false AND "p"."dead_letter"=false AND ("p"."notify_at">? OR "p"."delivering_until">?))`,
+			time.Now().UTC(),
+			time.Now().UTC(),
+		)
+	if len(skip) > 0 {
+		q = q.Where(`"service_id" NOT IN (?)`, skip)
+	}
+
+	res := q.
+		Order(`"id" asc`).
+		Limit(max).
+		Find(&m)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	list := make([]*types.Incoming, len(m))
+	for i, v := range m {
+		s, err := v.MapTo()
+		if err != nil {
+			return nil, err
+		}
+		list[i] = s
+	}
+	return list, nil
+}
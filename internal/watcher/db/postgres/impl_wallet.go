@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"github.com/void616/gm.mint.sender/internal/watcher/db/postgres/model"
+	"github.com/void616/gm.mint.sender/internal/watcher/db/types"
+)
+
+// PutWallet implementation
+func (d *Database) PutWallet(v ...*types.Wallet) error {
+	mlist := make([]*model.Wallet, 0)
+	for _, w := range v {
+		m := &model.Wallet{}
+		if err := m.MapFrom(w); err != nil {
+			return err
+		}
+		mlist = append(mlist, m)
+	}
+	tx := d.Begin()
+	txok := false
+	defer func() {
+		if !txok {
+			tx.Rollback()
+		}
+	}()
+	for _, m := range mlist {
+		dup, err := d.createSkipDuplicate(tx, m)
+		if err != nil {
+			return err
+		}
+		// update subscription
+		if dup {
+			if err := tx.Model(&model.Wallet{}).
+				Where(`"public_key"=? AND "service_id"=?`, m.PublicKey, m.Service.ID).
+				Updates(map[string]interface{}{
+					"direction": m.Direction,
+					"types":     m.Types,
+				}).
+				Error; err != nil {
+				return err
+			}
+		}
+	}
+	txok = true
+	return tx.Commit().Error
+}
+
+// ListWallets implementation
+func (d *Database) ListWallets() ([]*types.Wallet, error) {
+	mlist := make([]*model.Wallet, 0)
+	if err := d.Preload("Service").Find(&mlist).Error; err != nil {
+		return nil, err
+	}
+	list := make([]*types.Wallet, len(mlist))
+	for i, m := range mlist {
+		w, err := m.MapTo()
+		if err != nil {
+			return nil, err
+		}
+		list[i] = w
+	}
+	return list, nil
+}
+
+// DeleteWallet implementation
+func (d *Database) DeleteWallet(v ...*types.Wallet) error {
+	mlist := make([]*model.Wallet, 0)
+	for _, w := range v {
+		m := &model.Wallet{}
+		if err := m.MapFrom(w); err != nil {
+			return err
+		}
+		mlist = append(mlist, m)
+	}
+	tx := d.Begin()
+	txok := false
+	defer func() {
+		if !txok {
+			tx.Rollback()
+		}
+	}()
+	for _, m := range mlist {
+		if err := tx.Delete(&model.Wallet{}, `"public_key"=? AND "service_id"=?`, m.PublicKey, m.Service.ID).Error; err != nil {
+			return err
+		}
+	}
+	txok = true
+	return tx.Commit().Error
+}
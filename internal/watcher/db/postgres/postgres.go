@@ -0,0 +1,31 @@
+package postgres
+
+import (
+	"github.com/jinzhu/gorm"
+
+	// postgres driver init
+	_ "github.com/jinzhu/gorm/dialects/postgres"
+)
+
+// Database data
+type Database struct {
+	*gorm.DB
+	tablePrefix string
+}
+
+// New instance
+func New(connection, tablePrefix string) (*Database, error) {
+	gorm.DefaultTableNameHandler = func(db *gorm.DB, defaultTableName string) string {
+		return tablePrefix + defaultTableName
+	}
+
+	db, err := gorm.Open("postgres", connection)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Database{
+		DB:          db,
+		tablePrefix: tablePrefix,
+	}, nil
+}
@@ -0,0 +1,102 @@
+package sqlite
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/void616/gm.mint.sender/internal/watcher/db/sqlite/model"
+	gormigrate "gopkg.in/gormigrate.v1"
+)
+
+var migrations = []*gormigrate.Migration{
+
+	// initial
+	{
+		ID: "2019-09-27T10:08:24.153Z",
+		Migrate: func(tx *gorm.DB) error {
+			// foreign keys are omitted: sqlite can't alter table to add a constraint
+			return tx.
+				CreateTable(&model.Service{}).
+				AddUniqueIndex("ux_watcher_services_name", "name").
+				CreateTable(&model.Wallet{}).
+				AddUniqueIndex("ux_watcher_wallets_pubkeysvcid", "public_key", "service_id").
+				CreateTable(&model.Incoming{}).
+				AddUniqueIndex("ux_watcher_incomings_svcidtodigest", "service_id", "to", "digest").
+				AddIndex("ix_watcher_incomings_notified", "notified").
+				AddIndex("ix_watcher_incomings_notifyat", "notify_at").
+				CreateTable(&model.Setting{}).
+				Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.
+				DropTable(&model.Wallet{}).
+				DropTable(&model.Incoming{}).
+				DropTable(&model.Setting{}).
+				Error
+		},
+	},
+
+	// subscriptions: direction and transaction types
+	{
+		ID: "2026-10-18T09:12:41.205Z",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.
+				AutoMigrate(&model.Wallet{}).
+				AutoMigrate(&model.Incoming{}).
+				Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return nil
+		},
+	},
+	// incomings: notification delivery lease and attempts
+	{
+		ID: "2026-10-18T12:47:33.581Z",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.
+				AutoMigrate(&model.Incoming{}).
+				Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return nil
+		},
+	},
+	// incomings: notification dead letters
+	{
+		ID: "2026-10-18T13:58:16.730Z",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.
+				AutoMigrate(&model.Incoming{}).
+				Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return nil
+		},
+	},
+	// incomings: watching wallet, from/to are transaction parties
+	{
+		ID: "2026-10-18T15:22:47.619Z",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&model.Incoming{}).Error; err != nil {
+				return err
+			}
+			// "to" used to be the watching wallet, it's swapped with "from" for outgoing transactions
+			if err := tx.Model(&model.Incoming{}).UpdateColumn("wallet", gorm.Expr("`to`")).Error; err != nil {
+				return err
+			}
+			outgoing := tx.Model(&model.Incoming{}).Where("`outgoing`=?", true)
+			if err := outgoing.UpdateColumn("to", gorm.Expr("`from`")).Error; err != nil {
+				return err
+			}
+			if err := outgoing.UpdateColumn("from", gorm.Expr("`wallet`")).Error; err != nil {
+				return err
+			}
+			return tx.
+				Model(&model.Incoming{}).
+				RemoveIndex("ux_watcher_incomings_svcidtodigest").
+				AddUniqueIndex("ux_watcher_incomings_svcidwalletdigest", "service_id", "wallet", "digest").
+				Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return nil
+		},
+	},
+}
@@ -0,0 +1,67 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/watcher/db/types"
+	"github.com/void616/gm.mint/transaction"
+)
+
+// Wallet model
+type Wallet struct {
+	PublicKey []byte `gorm:"SIZE:32;NOT NULL"`
+	ServiceID uint64 `gorm:"NOT NULL"`
+	Service   Service
+	Direction uint8  `gorm:"NOT NULL;DEFAULT:1"`
+	Types     string `gorm:"SIZE:128;NOT NULL;DEFAULT:'transfer_asset'"`
+}
+
+// MapFrom mapping
+func (w *Wallet) MapFrom(t *types.Wallet) error {
+	svc := Service{}
+	if err := (&svc).MapFrom(&t.Service); err != nil {
+		return err
+	}
+
+	names := make([]string, len(t.Types))
+	for i, c := range t.Types {
+		names[i] = c.String()
+	}
+
+	w.PublicKey = t.PublicKey.Bytes()
+	w.Service = svc
+	w.Direction = uint8(t.Direction)
+	w.Types = strings.Join(names, ",")
+	return nil
+}
+
+// MapTo mapping
+func (w *Wallet) MapTo() (*types.Wallet, error) {
+	svc, err := (&w.Service).MapTo()
+	if err != nil {
+		return nil, err
+	}
+	pub, err := mint.BytesToPublicKey(w.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key")
+	}
+	codes := make([]transaction.Code, 0)
+	for _, s := range strings.Split(w.Types, ",") {
+		if s == "" {
+			continue
+		}
+		c, err := transaction.ParseCode(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transaction type")
+		}
+		codes = append(codes, c)
+	}
+	return &types.Wallet{
+		PublicKey: pub,
+		Service:   *svc,
+		Direction: types.Direction(w.Direction),
+		Types:     codes,
+	}, nil
+}
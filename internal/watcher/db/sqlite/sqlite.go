@@ -0,0 +1,33 @@
+package sqlite
+
+import (
+	"github.com/jinzhu/gorm"
+
+	// sqlite driver init
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+)
+
+// Database data
+type Database struct {
+	*gorm.DB
+	tablePrefix string
+}
+
+// New instance, connection is a path to DB file.
+// SQLite allows a single writer, so connections are serialized instead of failing with "database is locked"
+func New(connection, tablePrefix string) (*Database, error) {
+	gorm.DefaultTableNameHandler = func(db *gorm.DB, defaultTableName string) string {
+		return tablePrefix + defaultTableName
+	}
+
+	db, err := gorm.Open("sqlite3", connection)
+	if err != nil {
+		return nil, err
+	}
+	db.DB().SetMaxOpenConns(1)
+
+	return &Database{
+		DB:          db,
+		tablePrefix: tablePrefix,
+	}, nil
+}
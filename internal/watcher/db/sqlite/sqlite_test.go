@@ -0,0 +1,95 @@
+package sqlite
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/watcher/db/types"
+	"github.com/void616/gm.mint/amount"
+)
+
+func TestListUnnotifiedIncomings(t *testing.T) {
+	d, err := New("file:"+t.Name()+"?mode=memory&cache=shared", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	if err := d.Migrate(); err != nil {
+		t.Fatal(err)
+	}
+
+	services := make(map[string]*types.Service)
+	for _, name := range []string{"a", "b"} {
+		if err := d.PutService(&types.Service{Name: name, Transport: types.ServiceHTTP}); err != nil {
+			t.Fatal(err)
+		}
+		s, err := d.GetService(name)
+		if err != nil || s == nil {
+			t.Fatal("failed to get service", err)
+		}
+		services[name] = s
+	}
+
+	// digest's first byte is an incoming number: a1, a2, b1, a3
+	for i, name := range []string{"a", "a", "b", "a"} {
+		if err := d.PutIncoming(&types.Incoming{
+			Service: *services[name],
+			Amount:  amount.MustFromString("1"),
+			Token:   mint.TokenGOLD,
+			Digest:  mint.Digest{byte(i)},
+			Block:   big.NewInt(1),
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ids := make([]uint64, 4)
+
+	expect := func(skip []uint64, want ...byte) {
+		t.Helper()
+		list, err := d.ListUnnotifiedIncomings(10, skip)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := make([]byte, len(list))
+		for i, v := range list {
+			got[i] = v.Digest[0]
+			ids[v.Digest[0]] = v.ID
+		}
+		if string(got) != string(want) {
+			t.Fatalf("got %v, expected %v", got, want)
+		}
+	}
+
+	// in order of creation
+	expect(nil, 0, 1, 2, 3)
+	expect([]uint64{services["a"].ID}, 2)
+
+	// later incomings of the service wait for the one being delivered
+	if ok, err := d.LeaseIncomingNotification(ids[0], time.Now().Add(time.Minute)); err != nil || !ok {
+		t.Fatal("failed to lease", err)
+	}
+	expect(nil, 2)
+
+	// ...or awaiting a retry
+	if err := d.CompleteIncomingNotification(ids[0], false, time.Now().Add(time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	expect(nil, 2)
+
+	// retry is due
+	if err := d.CompleteIncomingNotification(ids[0], false, time.Now().Add(-time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	expect(nil, 0, 1, 2, 3)
+
+	// notified and dead incomings don't hold the service
+	if err := d.CompleteIncomingNotification(ids[0], true, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetIncomingDeadLetter(ids[1]); err != nil {
+		t.Fatal(err)
+	}
+	expect(nil, 2, 3)
+}
@@ -6,20 +6,47 @@ import (
 
 	mint "github.com/void616/gm.mint"
 	"github.com/void616/gm.mint/amount"
+	"github.com/void616/gm.mint/transaction"
 )
 
 // Incoming model
 type Incoming struct {
-	ID            uint64
-	Service       Service
-	To            mint.PublicKey
-	From          mint.PublicKey
-	Amount        *amount.Amount
-	Token         mint.Token
-	Digest        mint.Digest
-	Block         *big.Int
-	Timestamp     time.Time
-	FirstNotifyAt *time.Time
-	NotifyAt      *time.Time
-	Notified      bool
+	ID      uint64
+	Service Service
+	// Wallet is the watching wallet
+	Wallet mint.PublicKey
+	// From is a sender of the transaction
+	From mint.PublicKey
+	// To is a recipient of the transaction (empty if the transaction has no recipient)
+	To mint.PublicKey
+	// Outgoing is true if the transaction is sent by the watching wallet
+	Outgoing bool
+	// Type of the transaction
+	Type transaction.Code
+	// Data is an optional payload (wallet tag, user data)
+	Data            []byte
+	Amount          *amount.Amount
+	Token           mint.Token
+	Digest          mint.Digest
+	Block           *big.Int
+	Timestamp       time.Time
+	FirstNotifyAt   *time.Time
+	NotifyAt        *time.Time
+	Notified        bool
+	NotifyAttempts  uint32
+	DeliveringUntil *time.Time
+	DeadLetter      bool
+}
+
+// Counterparty gets the other party of the transaction (empty if the transaction has no counterparty)
+func (i *Incoming) Counterparty() mint.PublicKey {
+	if i.Outgoing {
+		return i.To
+	}
+	return i.From
+}
+
+// Refill is true if the transaction is an incoming asset transfer
+func (i *Incoming) Refill() bool {
+	return !i.Outgoing && i.Type == transaction.TransferAssetTx
 }
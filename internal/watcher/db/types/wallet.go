@@ -2,10 +2,106 @@ package types
 
 import (
 	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint/transaction"
 )
 
 // Wallet model
 type Wallet struct {
 	PublicKey mint.PublicKey
 	Service   Service
+	// Direction of transactions to watch
+	Direction Direction
+	// Types of transactions to watch
+	Types []transaction.Code
+}
+
+// Watches checks the wallet subscription includes a transaction of specified type and direction
+func (w *Wallet) Watches(typ transaction.Code, outgoing bool) bool {
+	if outgoing && !w.Direction.Out() || !outgoing && !w.Direction.In() {
+		return false
+	}
+	for _, t := range w.Types {
+		if t == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// Direction is a direction of transactions to watch
+type Direction uint8
+
+const (
+	// DirectionIn means incoming transactions
+	DirectionIn Direction = 1 << iota
+	// DirectionOut means outgoing transactions
+	DirectionOut
+	// DirectionBoth means incoming and outgoing transactions
+	DirectionBoth = DirectionIn | DirectionOut
+)
+
+// In direction
+func (d Direction) In() bool { return d&DirectionIn != 0 }
+
+// Out direction
+func (d Direction) Out() bool { return d&DirectionOut != 0 }
+
+// String representation
+func (d Direction) String() string {
+	switch d {
+	case DirectionIn:
+		return "in"
+	case DirectionOut:
+		return "out"
+	case DirectionBoth:
+		return "both"
+	}
+	return ""
+}
+
+// ParseDirection from string, empty string means incoming
+func ParseDirection(s string) (Direction, bool) {
+	switch s {
+	case "", "in":
+		return DirectionIn, true
+	case "out":
+		return DirectionOut, true
+	case "both":
+		return DirectionBoth, true
+	}
+	return 0, false
+}
+
+// WatchableTypes is a list of transaction types available to watch
+var WatchableTypes = []transaction.Code{
+	transaction.TransferAssetTx,
+	transaction.SetWalletTagTx,
+	transaction.UnsetWalletTagTx,
+	transaction.UserDataTx,
+}
+
+// ParseTypes from strings, empty list means asset transfer only
+func ParseTypes(list []string) ([]transaction.Code, bool) {
+	if len(list) == 0 {
+		return []transaction.Code{transaction.TransferAssetTx}, true
+	}
+	ret := make([]transaction.Code, 0, len(list))
+	for _, s := range list {
+		c, err := transaction.ParseCode(s)
+		if err != nil {
+			return nil, false
+		}
+		watchable := false
+		for _, w := range WatchableTypes {
+			if w == c {
+				watchable = true
+				break
+			}
+		}
+		if !watchable {
+			return nil, false
+		}
+		ret = append(ret, c)
+	}
+	return ret, true
 }
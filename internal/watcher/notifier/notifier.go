@@ -1,30 +1,52 @@
 package notifier
 
 import (
+	"time"
+
 	"github.com/sirupsen/logrus"
 	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/alert"
+	"github.com/void616/gm.mint.sender/internal/retry"
+	"github.com/void616/gm.mint.sender/internal/wakeup"
 	"github.com/void616/gm.mint.sender/internal/watcher/db"
+	"github.com/void616/gm.mint.sender/internal/watcher/db/types"
 	"github.com/void616/gm.mint/amount"
 )
 
 const itemsPerShot = 50
 
-// Notifier sends refilling notifications
+// defaultWorkers is a default number of concurrent deliveries
+const defaultWorkers = 8
+
+// defaultHostWorkers is a default number of concurrent deliveries to the same callback host
+const defaultHostWorkers = 2
+
+// deliveryLease is max duration of a notification delivery attempt, unfinished delivery is retried once it expires
+const deliveryLease = time.Minute * 2
+
+// Notifier sends refilling and transaction notifications
 type Notifier struct {
-	logger    *logrus.Entry
-	natsTrans NatsTransporter
-	httpTrans HTTPTransporter
-	dao       db.DAO
+	logger      *logrus.Entry
+	alerter     alert.Alerter
+	natsTrans   NatsTransporter
+	httpTrans   HTTPTransporter
+	dao         db.DAO
+	retry       *retry.Policies
+	workers     uint16
+	hostWorkers uint16
+	wakeup      *wakeup.Bus
 }
 
 // NatsTransporter delivers notifications or fail with an error
 type NatsTransporter interface {
 	NotifyRefilling(service string, to, from mint.PublicKey, t mint.Token, a *amount.Amount, tx mint.Digest) error
+	NotifyTransaction(service string, inc *types.Incoming) error
 }
 
 // HTTPTransporter delivers notifications or fail with an error
 type HTTPTransporter interface {
 	NotifyRefilling(url, service string, to, from mint.PublicKey, t mint.Token, a *amount.Amount, tx mint.Digest) error
+	NotifyTransaction(url, service string, inc *types.Incoming) error
 }
 
 // New Notifier instance
@@ -32,13 +54,38 @@ func New(
 	dao db.DAO,
 	natsTrans NatsTransporter,
 	httpTrans HTTPTransporter,
+	alerter alert.Alerter,
 	logger *logrus.Entry,
 ) (*Notifier, error) {
 	n := &Notifier{
-		logger:    logger,
-		dao:       dao,
-		natsTrans: natsTrans,
-		httpTrans: httpTrans,
+		logger:      logger,
+		alerter:     alerter,
+		dao:         dao,
+		natsTrans:   natsTrans,
+		httpTrans:   httpTrans,
+		workers:     defaultWorkers,
+		hostWorkers: defaultHostWorkers,
 	}
 	return n, nil
 }
+
+// AddRetry sets notification retry policies by service (see retry.Default() otherwise) and should be called before service launch
+func (n *Notifier) AddRetry(p *retry.Policies) {
+	n.retry = p
+}
+
+// AddWakeup sets a bus to deliver fresh notifications immediately and should be called before service launch
+func (n *Notifier) AddWakeup(b *wakeup.Bus) {
+	n.wakeup = b
+}
+
+// AddWorkers sets a number of concurrent deliveries and concurrent deliveries to the same callback host (zero to keep defaults).
+// Notifications of the same service are delivered one by one in order. Should be called before service launch
+func (n *Notifier) AddWorkers(workers, hostWorkers uint16) {
+	if workers > 0 {
+		n.workers = workers
+	}
+	if hostWorkers > 0 {
+		n.hostWorkers = hostWorkers
+	}
+}
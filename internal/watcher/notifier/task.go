@@ -1,8 +1,12 @@
 package notifier
 
 import (
+	"net/url"
+	"strconv"
 	"time"
 
+	"github.com/void616/gm.mint.sender/internal/delivery"
+	"github.com/void616/gm.mint.sender/internal/wakeup"
 	"github.com/void616/gm.mint.sender/internal/watcher/db/types"
 	"github.com/void616/gotask"
 )
@@ -10,10 +14,30 @@ import (
 // Task loop
 func (n *Notifier) Task(token *gotask.Token) {
 
+	pool := delivery.New(int(n.workers), int(n.hostWorkers))
+	pool.Start()
+	defer pool.Stop()
+
+	kicked := n.wakeup.Subscribe(wakeup.Notification)
+
 	for !token.Stopped() {
 
+		// enough work
+		if pool.Jobs() >= itemsPerShot {
+			token.Sleep(time.Second)
+			continue
+		}
+
+		// services with queued or running deliveries
+		skip := make([]uint64, 0)
+		for _, d := range pool.Destinations() {
+			if id, err := strconv.ParseUint(d, 10, 64); err == nil {
+				skip = append(skip, id)
+			}
+		}
+
 		// get list
-		list, err := n.dao.ListUnnotifiedIncomings(itemsPerShot)
+		list, err := n.dao.ListUnnotifiedIncomings(itemsPerShot, skip)
 		if err != nil {
 			n.logger.WithError(err).Error("Failed to get unsent items")
 			token.Sleep(time.Second * 30)
@@ -22,108 +46,128 @@ func (n *Notifier) Task(token *gotask.Token) {
 
 		// nothing
 		if len(list) == 0 {
-			token.Sleep(time.Second * 30)
+			if len(skip) > 0 {
+				token.Sleep(time.Second)
+			} else {
+				wakeup.Sleep(token, kicked, time.Second*30)
+			}
 			continue
 		}
 
-		out := false
 		for _, inc := range list {
-			if out {
-				break
-			}
+			inc := inc
+			pool.Submit(delivery.Job{
+				Destination: strconv.FormatUint(inc.Service.ID, 10),
+				Host:        callbackHost(inc.Service),
+				Run: func() bool {
+					return n.notify(inc)
+				},
+			})
+		}
+	}
+}
 
-			// mark as notified
-			{
-				now := time.Now().UTC()
-				if inc.FirstNotifyAt == nil {
-					inc.FirstNotifyAt = &now
-				}
-				inc.NotifyAt = &now
-				inc.Notified = true
-			}
-			if err := n.dao.UpdateIncoming(inc); err != nil {
-				n.logger.
-					WithError(err).
-					WithField("wallet", inc.To.String()).
-					WithField("tx", inc.Digest.String()).
-					Error("Failed to update incoming")
-				token.Sleep(time.Second * 30)
-				out = true
-				continue
-			}
+// notify delivers a notification, false means the rest of service's notifications should wait
+func (n *Notifier) notify(inc *types.Incoming) bool {
 
-			// notify
-			var notiErr error
-			switch inc.Service.Transport {
-			case types.ServiceNats:
-				if n.natsTrans != nil {
-					notiErr = n.natsTrans.NotifyRefilling(inc.Service.Name, inc.To, inc.From, inc.Token, inc.Amount, inc.Digest)
-				} else {
-					n.logger.Warn("Nats transport is disabled, skipping notification")
-					continue
-				}
-			case types.ServiceHTTP:
-				if n.httpTrans != nil {
-					if inc.Service.CallbackURL != "" {
-						notiErr = n.httpTrans.NotifyRefilling(inc.Service.CallbackURL, inc.Service.Name, inc.To, inc.From, inc.Token, inc.Amount, inc.Digest)
-					}
-				} else {
-					n.logger.Warn("HTTP transport is disabled, skipping notification")
-					continue
-				}
-			default:
-				n.logger.Errorf("Transport %v is not implemented", inc.Service.Transport)
-				continue
-			}
+	// lease for delivery, the incoming is picked up again once the lease expires without completion
+	leased, err := n.dao.LeaseIncomingNotification(inc.ID, time.Now().UTC().Add(deliveryLease))
+	if err != nil {
+		n.logger.
+			WithError(err).
+			WithField("wallet", inc.Wallet.String()).
+			WithField("tx", inc.Digest.String()).
+			Error("Failed to lease incoming")
+		return false
+	}
+	if !leased {
+		// notified or leased meanwhile
+		return false
+	}
+	{
+		now := time.Now().UTC()
+		if inc.FirstNotifyAt == nil {
+			inc.FirstNotifyAt = &now
+		}
+		inc.NotifyAttempts++
+	}
 
-			if notiErr != nil {
-				n.logger.
-					WithField("wallet", inc.To.String()).
-					WithField("tx", inc.Digest.String()).
-					WithError(notiErr).
-					Error("Failed to notify")
-
-				// notify next time
-				when := time.Now().UTC()
-				if inc.FirstNotifyAt != nil {
-					mikes := time.Now().UTC().Sub(*inc.FirstNotifyAt).Minutes()
-					switch {
-					// for 5m: every 1m
-					case mikes < 5:
-						when = when.Add(time.Minute)
-					// then for 30m: every 5m
-					case mikes < 35:
-						when = when.Add(time.Minute * 5)
-					// then for 60m: every 10m
-					case mikes < 95:
-						when = when.Add(time.Minute * 10)
-					// then every 120m
-					default:
-						when = when.Add(time.Minute * 120)
-					}
+	// notify
+	var notiErr error
+	switch inc.Service.Transport {
+	case types.ServiceNats:
+		if n.natsTrans != nil {
+			if inc.Refill() {
+				notiErr = n.natsTrans.NotifyRefilling(inc.Service.Name, inc.To, inc.From, inc.Token, inc.Amount, inc.Digest)
+			} else {
+				notiErr = n.natsTrans.NotifyTransaction(inc.Service.Name, inc)
+			}
+		} else {
+			n.logger.Warn("Nats transport is disabled, skipping notification")
+		}
+	case types.ServiceHTTP:
+		if n.httpTrans != nil {
+			if inc.Service.CallbackURL != "" {
+				if inc.Refill() {
+					notiErr = n.httpTrans.NotifyRefilling(inc.Service.CallbackURL, inc.Service.Name, inc.To, inc.From, inc.Token, inc.Amount, inc.Digest)
 				} else {
-					when = when.Add(time.Hour * 24 * 365)
-				}
-
-				// mark as unnotified
-				inc.NotifyAt = &when
-				inc.Notified = false
-				if err := n.dao.UpdateIncoming(inc); err != nil {
-					n.logger.
-						WithError(err).
-						WithField("wallet", inc.To.String()).
-						WithField("tx", inc.Digest.String()).
-						Error("Failed to update incoming")
-					token.Sleep(time.Second * 30)
-					out = true
-					continue
+					notiErr = n.httpTrans.NotifyTransaction(inc.Service.CallbackURL, inc.Service.Name, inc)
 				}
-			} else {
-				n.logger.
-					WithField("wallet", inc.To.String()).
-					WithField("tx", inc.Digest.String()).
-					Info("Notified")
 			}
+		} else {
+			n.logger.Warn("HTTP transport is disabled, skipping notification")
 		}
+	default:
+		n.logger.Errorf("Transport %v is not implemented", inc.Service.Transport)
+	}
+
+	// notify next time or move to dead letters
+	when, alive := time.Now().UTC(), true
+	if notiErr != nil {
+		n.logger.
+			WithField("wallet", inc.Wallet.String()).
+			WithField("tx", inc.Digest.String()).
+			WithField("attempt", inc.NotifyAttempts).
+			WithError(notiErr).
+			Error("Failed to notify")
+		when, alive = n.retry.Get(inc.Service.Name).Next(*inc.FirstNotifyAt, inc.NotifyAttempts, when)
+	} else {
+		n.logger.
+			WithField("wallet", inc.Wallet.String()).
+			WithField("tx", inc.Digest.String()).
+			Info("Notified")
+	}
+
+	if alive {
+		// mark as notified (or unnotified) and release the lease
+		err = n.dao.CompleteIncomingNotification(inc.ID, notiErr == nil, when)
+	} else {
+		n.logger.
+			WithField("wallet", inc.Wallet.String()).
+			WithField("tx", inc.Digest.String()).
+			Warn("Notification retries are exhausted, moved to dead letters")
+		n.alerter.LimitWarn(time.Hour, "Notifications to service %v are moved to dead letters", inc.Service.Name)
+		err = n.dao.SetIncomingDeadLetter(inc.ID)
+	}
+	if err != nil {
+		n.logger.
+			WithError(err).
+			WithField("wallet", inc.Wallet.String()).
+			WithField("tx", inc.Digest.String()).
+			Error("Failed to update incoming")
+		return false
+	}
+	return notiErr == nil || !alive
+}
+
+// callbackHost gets a host of HTTP callback to limit concurrent deliveries
+func callbackHost(s types.Service) string {
+	if s.Transport != types.ServiceHTTP || s.CallbackURL == "" {
+		return ""
+	}
+	u, err := url.Parse(s.CallbackURL)
+	if err != nil {
+		return ""
 	}
+	return u.Host
 }
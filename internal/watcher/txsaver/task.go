@@ -1,10 +1,11 @@
 package txsaver
 
 import (
-	"math/big"
 	"time"
 
 	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/mint/blockparser"
+	"github.com/void616/gm.mint.sender/internal/wakeup"
 	"github.com/void616/gm.mint.sender/internal/watcher/db/types"
 	"github.com/void616/gm.mint/amount"
 	"github.com/void616/gm.mint/transaction"
@@ -19,7 +20,6 @@ func (s *Saver) Task(token *gotask.Token) {
 	s.logger.Debugf("%v wallets within ROI", len(s.subs))
 
 	empty := false
-	zero := big.NewInt(0)
 
 	for !token.Stopped() || !empty {
 		empty = false
@@ -29,66 +29,20 @@ func (s *Saver) Task(token *gotask.Token) {
 
 			// save next filtered transaction
 			case tx := <-s.transactions:
-				// asset transaction
-				if tx.Type != transaction.TransferAssetTx {
-					break
-				}
-
-				// destination is known
-				if tx.To == nil {
-					break
-				}
-
-				// some coins are transferred
-				var tkn mint.Token
-				var amo *amount.Amount
-				switch {
-				case tx.AmountMNT.Value.Cmp(zero) > 0:
-					tkn = mint.TokenMNT
-					amo = amount.FromAmount(tx.AmountMNT)
-				case tx.AmountGOLD.Value.Cmp(zero) > 0:
-					tkn = mint.TokenGOLD
-					amo = amount.FromAmount(tx.AmountGOLD)
-				}
-				if amo == nil {
-					break
+				if s.save(token, tx) {
+					savedItems++
 				}
 
-				// destination has subscribed services
-				if svcs, ok := s.subs[*tx.To]; !ok || len(svcs) == 0 {
-					break
-				}
-
-				// incoming per service
-				models := make([]*types.Incoming, len(s.subs[*tx.To]))
-				{
-					i := 0
-					for _, svc := range s.subs[*tx.To] {
-						models[i] = &types.Incoming{
-							Service:   svc,
-							To:        *tx.To,
-							From:      tx.From,
-							Amount:    amo,
-							Token:     tkn,
-							Digest:    tx.Digest,
-							Block:     tx.Block,
-							Timestamp: tx.Timestamp,
-						}
-						i++
-					}
-				}
-
-				// save to death
-				saved := false
-				for !token.Stopped() && !saved {
-					if err := s.dao.PutIncoming(models...); err != nil {
-						s.logger.WithError(err).WithField("digest", tx.Digest.String()).Errorf("Failed to save transaction")
-						token.Sleep(time.Second * 10)
-					} else {
-						saved = true
+			// transactions received before the checkpoint are buffered in the channel already
+			case id := <-s.checkpointIn:
+				for n := len(s.transactions); n > 0; n-- {
+					if s.save(token, <-s.transactions) {
 						savedItems++
 					}
 				}
+				if !token.Stopped() {
+					s.checkpointOut <- id
+				}
 
 			// add/remove wallet:service pair
 			case pair := <-s.walletSubs:
@@ -98,9 +52,14 @@ func (s *Saver) Task(token *gotask.Token) {
 						s.subs[pair.PublicKey] = servicesMap{}
 					}
 					if _, ok := s.subs[pair.PublicKey][pair.Service.Name]; !ok {
-						s.subs[pair.PublicKey][pair.Service.Name] = pair.Service
 						s.logger.Debugf("Pair %v:%v added to ROI", pair.PublicKey.StringMask(), pair.Service.Name)
 					}
+					s.subs[pair.PublicKey][pair.Service.Name] = types.Wallet{
+						PublicKey: pair.PublicKey,
+						Service:   pair.Service,
+						Direction: pair.Direction,
+						Types:     pair.Types,
+					}
 					break
 				}
 				// remove
@@ -125,3 +84,86 @@ func (s *Saver) Task(token *gotask.Token) {
 		}
 	}
 }
+
+// save saves incomings of the transaction to death, returns false if there is nothing to save or the task is stopped
+func (s *Saver) save(token *gotask.Token, tx *blockparser.Transaction) bool {
+	// incoming per subscribed service
+	models := s.incomings(tx)
+	if len(models) == 0 {
+		return false
+	}
+
+	for !token.Stopped() {
+		if err := s.dao.PutIncoming(models...); err != nil {
+			s.logger.WithError(err).WithField("digest", tx.Digest.String()).Errorf("Failed to save transaction")
+			s.alerter.LimitError(time.Minute*30, "Saver fails to save transactions into DB")
+			token.Sleep(time.Second * 10)
+			continue
+		}
+		s.wakeup.Kick(wakeup.Notification)
+		return true
+	}
+	return false
+}
+
+// incomings makes a list of incomings (per subscribed service) from the transaction.
+// `subsLock` should be locked at the time of the method call
+func (s *Saver) incomings(tx *blockparser.Transaction) []*types.Incoming {
+	// some coins are transferred
+	var tkn mint.Token
+	var amo = amount.New()
+	if tx.Type == transaction.TransferAssetTx {
+		switch {
+		case tx.AmountMNT.Value.Sign() > 0:
+			tkn = mint.TokenMNT
+			amo = amount.FromAmount(tx.AmountMNT)
+		case tx.AmountGOLD.Value.Sign() > 0:
+			tkn = mint.TokenGOLD
+			amo = amount.FromAmount(tx.AmountGOLD)
+		default:
+			return nil
+		}
+	}
+
+	list := make([]*types.Incoming, 0)
+	seen := make(map[string]struct{})
+	add := func(wallet mint.PublicKey, outgoing bool) {
+		for _, sub := range s.subs[wallet] {
+			if !sub.Watches(tx.Type, outgoing) {
+				continue
+			}
+			// transaction to itself is saved once
+			key := sub.Service.Name + wallet.String()
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+
+			inc := &types.Incoming{
+				Service:   sub.Service,
+				Wallet:    wallet,
+				From:      tx.From,
+				Outgoing:  outgoing,
+				Type:      tx.Type,
+				Data:      tx.Data,
+				Amount:    amo,
+				Token:     tkn,
+				Digest:    tx.Digest,
+				Block:     tx.Block,
+				Timestamp: tx.Timestamp,
+			}
+			if tx.To != nil {
+				inc.To = *tx.To
+			}
+			list = append(list, inc)
+		}
+	}
+
+	// incoming
+	if tx.To != nil {
+		add(*tx.To, false)
+	}
+	// outgoing
+	add(tx.From, true)
+	return list
+}
@@ -1,11 +1,14 @@
 package txsaver
 
 import (
+	"math/big"
 	"sync"
 
 	"github.com/sirupsen/logrus"
 	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/alert"
 	"github.com/void616/gm.mint.sender/internal/mint/blockparser"
+	"github.com/void616/gm.mint.sender/internal/wakeup"
 	"github.com/void616/gm.mint.sender/internal/watcher/api/model"
 	"github.com/void616/gm.mint.sender/internal/watcher/db"
 	"github.com/void616/gm.mint.sender/internal/watcher/db/types"
@@ -14,15 +17,20 @@ import (
 // Saver saves filtered transactions to the DB
 type Saver struct {
 	logger         *logrus.Entry
+	alerter        alert.Alerter
 	transactions   <-chan *blockparser.Transaction
 	walletSubs     <-chan model.WalletSub
 	unfilterWallet chan<- mint.PublicKey
 	dao            db.DAO
 	subs           map[mint.PublicKey]servicesMap
 	subsLock       sync.Mutex
+	wakeup         *wakeup.Bus
+	checkpointIn   <-chan *big.Int
+	checkpointOut  chan<- *big.Int
 }
 
-type servicesMap map[string]types.Service
+// servicesMap contains wallet subscriptions by service name
+type servicesMap map[string]types.Wallet
 
 // New Saver instance
 func New(
@@ -30,10 +38,12 @@ func New(
 	walletSubs <-chan model.WalletSub,
 	unfilterWallet chan<- mint.PublicKey,
 	dao db.DAO,
+	alerter alert.Alerter,
 	logger *logrus.Entry,
 ) (*Saver, error) {
 	f := &Saver{
 		logger:         logger,
+		alerter:        alerter,
 		transactions:   transactions,
 		walletSubs:     walletSubs,
 		dao:            dao,
@@ -43,14 +53,26 @@ func New(
 	return f, nil
 }
 
-// AddWalletSubs adds subscribers of the specific wallet
-func (s *Saver) AddWalletSubs(p mint.PublicKey, services ...types.Service) {
+// AddCheckpoints passes block IDs from `in` to `out` once transactions received before are saved.
+// Should be called before service launch
+func (s *Saver) AddCheckpoints(in <-chan *big.Int, out chan<- *big.Int) {
+	s.checkpointIn = in
+	s.checkpointOut = out
+}
+
+// AddWakeup sets a bus to kick the notifier once transactions are saved and should be called before service launch
+func (s *Saver) AddWakeup(b *wakeup.Bus) {
+	s.wakeup = b
+}
+
+// AddWalletSubs adds wallets subscriptions
+func (s *Saver) AddWalletSubs(wallets ...*types.Wallet) {
 	s.subsLock.Lock()
 	defer s.subsLock.Unlock()
-	for _, svc := range services {
-		if _, ok := s.subs[p]; !ok {
-			s.subs[p] = servicesMap{}
+	for _, w := range wallets {
+		if _, ok := s.subs[w.PublicKey]; !ok {
+			s.subs[w.PublicKey] = servicesMap{}
 		}
-		s.subs[p][svc.Name] = svc
+		s.subs[w.PublicKey][w.Service.Name] = *w
 	}
 }
@@ -0,0 +1,129 @@
+package txsaver
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	mint "github.com/void616/gm.mint"
+	"github.com/void616/gm.mint.sender/internal/mint/blockparser"
+	"github.com/void616/gm.mint.sender/internal/watcher/db/types"
+	"github.com/void616/gm.mint/amount"
+	"github.com/void616/gm.mint/signer"
+	"github.com/void616/gm.mint/transaction"
+)
+
+func TestIncomings(t *testing.T) {
+	a, b := newWallet(t), newWallet(t)
+
+	s, err := New(nil, nil, nil, nil, nil, logrus.NewEntry(logrus.New()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sub := func(pub mint.PublicKey, service string, d types.Direction, typ ...transaction.Code) *types.Wallet {
+		return &types.Wallet{PublicKey: pub, Service: types.Service{Name: service}, Direction: d, Types: typ}
+	}
+	s.AddWalletSubs(
+		sub(a, "a_out", types.DirectionOut, transaction.TransferAssetTx),
+		sub(a, "a_in", types.DirectionIn, transaction.TransferAssetTx),
+		sub(a, "a_data", types.DirectionBoth, transaction.UserDataTx),
+		sub(b, "b_in", types.DirectionIn, transaction.TransferAssetTx),
+		sub(b, "b_tags", types.DirectionBoth, transaction.SetWalletTagTx),
+	)
+
+	// transfer a => b
+	list := s.incomings(&blockparser.Transaction{
+		Type:       transaction.TransferAssetTx,
+		From:       a,
+		To:         &b,
+		AmountGOLD: amount.MustFromString("1"),
+		AmountMNT:  amount.New(),
+		Block:      big.NewInt(1),
+	})
+	got := byService(list)
+	if len(list) != 2 || got["a_out"] == nil || got["b_in"] == nil {
+		t.Fatalf("unexpected incomings %v", services(list))
+	}
+	for _, inc := range list {
+		if inc.From != a || inc.To != b {
+			t.Fatalf("%v: transaction parties are %v => %v", inc.Service.Name, inc.From.StringMask(), inc.To.StringMask())
+		}
+	}
+	if inc := got["a_out"]; inc.Wallet != a || !inc.Outgoing || inc.Counterparty() != b || inc.Refill() {
+		t.Fatalf("unexpected outgoing %+v", inc)
+	}
+	if inc := got["b_in"]; inc.Wallet != b || inc.Outgoing || inc.Counterparty() != a || !inc.Refill() {
+		t.Fatalf("unexpected incoming %+v", inc)
+	}
+
+	// user data of a, no counterparty
+	list = s.incomings(&blockparser.Transaction{
+		Type:  transaction.UserDataTx,
+		From:  a,
+		Data:  []byte{1},
+		Block: big.NewInt(1),
+	})
+	if len(list) != 1 || list[0].Service.Name != "a_data" {
+		t.Fatalf("unexpected incomings %v", services(list))
+	}
+	if inc := list[0]; inc.Wallet != a || !inc.Outgoing || inc.Counterparty() != (mint.PublicKey{}) {
+		t.Fatalf("unexpected user data %+v", inc)
+	}
+
+	// transfer a => a is saved once per service
+	list = s.incomings(&blockparser.Transaction{
+		Type:       transaction.TransferAssetTx,
+		From:       a,
+		To:         &a,
+		AmountGOLD: amount.New(),
+		AmountMNT:  amount.MustFromString("1"),
+		Block:      big.NewInt(1),
+	})
+	if len(list) != 2 || byService(list)["a_in"] == nil || byService(list)["a_out"] == nil {
+		t.Fatalf("unexpected incomings %v", services(list))
+	}
+}
+
+func TestWatches(t *testing.T) {
+	w := types.Wallet{
+		Direction: types.DirectionIn,
+		Types:     []transaction.Code{transaction.TransferAssetTx, transaction.UserDataTx},
+	}
+	if !w.Watches(transaction.TransferAssetTx, false) || !w.Watches(transaction.UserDataTx, false) {
+		t.Fatal("incoming transaction isn't watched")
+	}
+	if w.Watches(transaction.TransferAssetTx, true) {
+		t.Fatal("outgoing transaction is watched")
+	}
+	if w.Watches(transaction.SetWalletTagTx, false) {
+		t.Fatal("transaction of other type is watched")
+	}
+	w.Direction = types.DirectionBoth
+	if !w.Watches(transaction.TransferAssetTx, true) || !w.Watches(transaction.TransferAssetTx, false) {
+		t.Fatal("transaction isn't watched in both directions")
+	}
+}
+
+func newWallet(t *testing.T) mint.PublicKey {
+	s, err := signer.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s.PublicKey()
+}
+
+func byService(list []*types.Incoming) map[string]*types.Incoming {
+	ret := make(map[string]*types.Incoming)
+	for _, inc := range list {
+		ret[inc.Service.Name] = inc
+	}
+	return ret
+}
+
+func services(list []*types.Incoming) []string {
+	ret := make([]string, 0, len(list))
+	for _, inc := range list {
+		ret = append(ret, inc.Service.Name)
+	}
+	return ret
+}
@@ -0,0 +1,64 @@
+// Package watermark tracks the latest block completed contiguously while blocks are completed by multiple producers
+package watermark
+
+import (
+	"math/big"
+)
+
+// Watermark is the latest block ID such that it and all the blocks before are completed
+type Watermark struct {
+	mark *big.Int
+	done map[string]struct{}
+}
+
+// New Watermark instance, mark is the latest completed block
+func New(mark *big.Int) *Watermark {
+	return &Watermark{
+		mark: new(big.Int).Set(mark),
+		done: make(map[string]struct{}),
+	}
+}
+
+// Complete marks the block as completed, returns true if the watermark is moved
+func (w *Watermark) Complete(id *big.Int) bool {
+	if id.Cmp(w.mark) <= 0 {
+		return false
+	}
+	w.done[id.String()] = struct{}{}
+
+	moved := false
+	next := new(big.Int).Add(w.mark, big.NewInt(1))
+	for {
+		if _, ok := w.done[next.String()]; !ok {
+			break
+		}
+		delete(w.done, next.String())
+		w.mark.Set(next)
+		next.Add(next, big.NewInt(1))
+		moved = true
+	}
+	return moved
+}
+
+// Mark gets the watermark
+func (w *Watermark) Mark() *big.Int {
+	return new(big.Int).Set(w.mark)
+}
+
+// Pending gets a number of completed blocks above the gap
+func (w *Watermark) Pending() int {
+	return len(w.done)
+}
+
+// Rollback forgets blocks completed after the fork block, the watermark is moved back to the fork block if it's ahead
+func (w *Watermark) Rollback(fork *big.Int) {
+	if w.mark.Cmp(fork) > 0 {
+		w.mark.Set(fork)
+	}
+	for k := range w.done {
+		id, _ := new(big.Int).SetString(k, 10)
+		if id.Cmp(fork) > 0 {
+			delete(w.done, k)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package watermark
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestComplete(t *testing.T) {
+	w := New(big.NewInt(10))
+
+	// gap at 11
+	for _, id := range []int64{12, 13, 10, 9} {
+		if w.Complete(big.NewInt(id)) {
+			t.Fatalf("watermark is moved by block %v", id)
+		}
+	}
+	if w.Mark().Int64() != 10 || w.Pending() != 2 {
+		t.Fatalf("watermark %v, pending %v", w.Mark(), w.Pending())
+	}
+
+	// gap is filled
+	if !w.Complete(big.NewInt(11)) {
+		t.Fatal("watermark isn't moved")
+	}
+	if w.Mark().Int64() != 13 || w.Pending() != 0 {
+		t.Fatalf("watermark %v, pending %v", w.Mark(), w.Pending())
+	}
+}
+
+func TestRollback(t *testing.T) {
+	w := New(big.NewInt(10))
+	for _, id := range []int64{11, 12, 14, 15} {
+		w.Complete(big.NewInt(id))
+	}
+
+	// blocks after 13 are forgotten, 12 is kept
+	w.Rollback(big.NewInt(13))
+	if w.Mark().Int64() != 12 || w.Pending() != 0 {
+		t.Fatalf("watermark %v, pending %v", w.Mark(), w.Pending())
+	}
+
+	// watermark is moved back
+	w.Rollback(big.NewInt(11))
+	if w.Mark().Int64() != 11 {
+		t.Fatalf("watermark %v", w.Mark())
+	}
+	if !w.Complete(big.NewInt(12)) || w.Mark().Int64() != 12 {
+		t.Fatalf("watermark %v", w.Mark())
+	}
+}
+
+func TestMarkIsCopied(t *testing.T) {
+	mark := big.NewInt(10)
+	w := New(mark)
+	mark.SetInt64(100)
+	w.Mark().SetInt64(200)
+	if w.Mark().Int64() != 10 {
+		t.Fatalf("watermark %v", w.Mark())
+	}
+}
+
+func TestRollbackPending(t *testing.T) {
+	w := New(big.NewInt(10))
+
+	// 12 is pending behind the gap at 11 and then replaced
+	if w.Complete(big.NewInt(12)) {
+		t.Fatal("watermark is moved over the gap")
+	}
+	w.Rollback(big.NewInt(11))
+	if w.Mark().Int64() != 10 || w.Pending() != 0 {
+		t.Fatalf("watermark %v, pending %v", w.Mark(), w.Pending())
+	}
+
+	// replaced block isn't counted once the gap is filled
+	if !w.Complete(big.NewInt(11)) || w.Mark().Int64() != 11 {
+		t.Fatalf("watermark %v", w.Mark())
+	}
+	if w.Complete(big.NewInt(13)) || w.Mark().Int64() != 11 {
+		t.Fatalf("watermark %v", w.Mark())
+	}
+}
@@ -20,6 +20,41 @@ func (sr SendRequest) String() string {
 	return fmt.Sprintf("id%v;%v%v;%v", sr.ID, sr.Amount, sr.Token, sr.PublicKey)
 }
 
+// SendBatchRequest is /send/batch request model
+type SendBatchRequest struct {
+	Service  string          `json:"service"`  // Service name (to differentiate multiple requestors): 1..64
+	Callback string          `json:"callback"` // Callback for notification: 1..256 or empty
+	Items    []SendBatchItem `json:"items"`    // Sending requests: 1..1000
+}
+
+// String implementation
+func (sr SendBatchRequest) String() string {
+	return fmt.Sprintf("%v;%v items", sr.Service, len(sr.Items))
+}
+
+// SendBatchItem is a single sending request within SendBatchRequest
+type SendBatchItem struct {
+	ID                string `json:"id"`                 // Unique request ID (within service): 1..64
+	PublicKey         string `json:"public_key"`         // Destination wallet address in Base58
+	Token             string `json:"token"`              // GOLD or MNT
+	Amount            string `json:"amount"`             // Token amount in major units: 1.234 (18 decimal places)
+	IgnoreApprovement bool   `json:"ignore_approvement"` // Indicates wallet may not be approved (valid only if sender has 'emission' tag)
+}
+
+// SendBatchResponse is /send/batch response model
+type SendBatchResponse struct {
+	Success bool              `json:"success"`         // Success is true in case the batch is processed (see items results)
+	Error   string            `json:"error,omitempty"` // Error contains error descrition in case of failure
+	Items   []SendBatchResult `json:"items,omitempty"` // Per-item results in order of the request items
+}
+
+// SendBatchResult is a result of a single sending request within SendBatchResponse
+type SendBatchResult struct {
+	ID     string `json:"id"`              // Request ID
+	Result string `json:"result"`          // Result: accepted, held, awaiting_approval, duplicate, rejected or invalid
+	Error  string `json:"error,omitempty"` // Error contains error descrition for invalid request
+}
+
 // ApproveRequest is /send request model
 type ApproveRequest struct {
 	Service   string `json:"service"`    // Service name (to differentiate multiple requestors): 1..64
@@ -33,24 +68,116 @@ func (sr ApproveRequest) String() string {
 	return fmt.Sprintf("id%v;%v", sr.ID, sr.PublicKey)
 }
 
+// SendStatusResponse is /send/{service}/{id} response model
+type SendStatusResponse struct {
+	Success     bool   `json:"success"`       // Success is true in case of success
+	Error       string `json:"error"`         // Error contains error descrition in case of failure
+	Status      string `json:"status"`        // Request status: enqueued, held, awaiting_approval, posted, included, confirmed, failed, cancelled or rejected
+	Service     string `json:"service"`       // Service name (to differentiate multiple requestors): 1..64
+	ID          string `json:"id"`            // Unique request ID (within service): 1..64
+	PublicKey   string `json:"public_key"`    // Destination wallet address in Base58
+	Token       string `json:"token"`         // GOLD or MNT
+	Amount      string `json:"amount"`        // Token amount in major units: 1.234 (18 decimal places)
+	Sender      string `json:"sender"`        // Sender wallet address in Base58 (empty until posted)
+	Nonce       uint64 `json:"nonce"`         // Sender wallet nonce (zero until posted)
+	Transaction string `json:"transaction"`   // Transaction digest in Base58 (empty until posted)
+	SentAtBlock string `json:"sent_at_block"` // Latest block ID at the moment of posting (empty until posted)
+	Block       string `json:"block"`         // Block ID containing the transaction (empty until included)
+	Notified    bool   `json:"notified"`      // Notified is true once the requestor is notified
+}
+
+// ApproveStatusResponse is /approve/{service}/{id} response model
+type ApproveStatusResponse struct {
+	Success     bool   `json:"success"`       // Success is true in case of success
+	Error       string `json:"error"`         // Error contains error descrition in case of failure
+	Status      string `json:"status"`        // Request status: enqueued, posted, included, confirmed, failed or cancelled
+	Service     string `json:"service"`       // Service name (to differentiate multiple requestors): 1..64
+	ID          string `json:"id"`            // Unique request ID (within service): 1..64
+	PublicKey   string `json:"public_key"`    // Destination wallet address in Base58
+	Sender      string `json:"sender"`        // Sender wallet address in Base58 (empty until posted)
+	Nonce       uint64 `json:"nonce"`         // Sender wallet nonce (zero until posted)
+	Transaction string `json:"transaction"`   // Transaction digest in Base58 (empty until posted)
+	SentAtBlock string `json:"sent_at_block"` // Latest block ID at the moment of posting (empty until posted)
+	Block       string `json:"block"`         // Block ID containing the transaction (empty until included)
+	Notified    bool   `json:"notified"`      // Notified is true once the requestor is notified
+}
+
+// PendingResponse is /admin/send response model
+type PendingResponse struct {
+	Success bool          `json:"success"`         // Success is true in case of success
+	Error   string        `json:"error,omitempty"` // Error contains error descrition in case of failure
+	Items   []PendingItem `json:"items,omitempty"` // Sending requests awaiting approval or held
+}
+
+// PendingItem is a single sending request within PendingResponse
+type PendingItem struct {
+	Status    string   `json:"status"`     // Request status: held or awaiting_approval
+	Service   string   `json:"service"`    // Service name (to differentiate multiple requestors): 1..64
+	ID        string   `json:"id"`         // Unique request ID (within service): 1..64
+	PublicKey string   `json:"public_key"` // Destination wallet address in Base58
+	Token     string   `json:"token"`      // GOLD or MNT
+	Amount    string   `json:"amount"`     // Token amount in major units: 1.234 (18 decimal places)
+	Approvers []string `json:"approvers"`  // Administrators approved the request so far
+}
+
+// AdminApproveResponse is /admin/send/{service}/{id}/approve response model
+type AdminApproveResponse struct {
+	Success   bool     `json:"success"`             // Success is true in case of success
+	Error     string   `json:"error,omitempty"`     // Error contains error descrition in case of failure
+	Status    string   `json:"status,omitempty"`    // Request status: awaiting_approval/held until approved by enough administrators, then enqueued
+	Approvers []string `json:"approvers,omitempty"` // Administrators approved the request so far
+}
+
+// AdminRejectRequest is /admin/send/{service}/{id}/reject request model
+type AdminRejectRequest struct {
+	Reason string `json:"reason"` // Reason to notify the requestor with: 0..256
+}
+
+// DeadResponse is /admin/dead response model
+type DeadResponse struct {
+	Success      bool       `json:"success"`                // Success is true in case of success
+	Error        string     `json:"error,omitempty"`        // Error contains error descrition in case of failure
+	Sendings     []DeadItem `json:"sendings,omitempty"`     // Sending requests with notification in dead letters
+	Approvements []DeadItem `json:"approvements,omitempty"` // Approvement requests with notification in dead letters
+}
+
+// DeadItem is a single request within DeadResponse
+type DeadItem struct {
+	Service       string `json:"service"`         // Service name (to differentiate multiple requestors): 1..64
+	ID            string `json:"id"`              // Unique request ID (within service): 1..64
+	Status        string `json:"status"`          // Request status: confirmed, failed, cancelled or rejected
+	Attempts      uint32 `json:"attempts"`        // Delivery attempts made
+	FirstNotifyAt string `json:"first_notify_at"` // Time of the first delivery attempt (RFC3339)
+}
+
 // SentEvent is notification model
 type SentEvent struct {
-	Success     bool   `json:"success"`     // Success is true in case of success
-	Error       string `json:"error"`       // Error contains error descrition in case of failure
-	Service     string `json:"service"`     // Service name (to differentiate multiple requestors): 1..64
-	ID          string `json:"id"`          // Unique request ID: 1..64
-	PublicKey   string `json:"public_key"`  // Destination wallet address in Base58 (empty on failure)
-	Token       string `json:"token"`       // GOLD or MNT (empty on failure)
-	Amount      string `json:"amount"`      // Token amount in major units: 1.234 (18 decimal places, empty on failure)
-	Transaction string `json:"transaction"` // Transaction digest in Base58 (empty on failure)
+	Success       bool   `json:"success"`       // Success is true in case of success
+	Error         string `json:"error"`         // Error contains error descrition in case of failure
+	Service       string `json:"service"`       // Service name (to differentiate multiple requestors): 1..64
+	ID            string `json:"id"`            // Unique request ID: 1..64
+	PublicKey     string `json:"public_key"`    // Destination wallet address in Base58 (empty on failure)
+	Token         string `json:"token"`         // GOLD or MNT (empty on failure)
+	Amount        string `json:"amount"`        // Token amount in major units: 1.234 (18 decimal places, empty on failure)
+	Transaction   string `json:"transaction"`   // Transaction digest in Base58 (empty on failure)
+	Confirmations uint64 `json:"confirmations"` // Number of blocks on top of the transaction's block (zero on failure)
 }
 
 // ApprovedEvent is notification model
 type ApprovedEvent struct {
-	Success     bool   `json:"success"`     // Success is true in case of success
-	Error       string `json:"error"`       // Error contains error descrition in case of failure
-	Service     string `json:"service"`     // Service name (to differentiate multiple requestors): 1..64
-	ID          string `json:"id"`          // Unique request ID: 1..64
-	PublicKey   string `json:"public_key"`  // Destination wallet address in Base58 (empty on failure)
-	Transaction string `json:"transaction"` // Transaction digest in Base58 (empty on failure)
+	Success       bool   `json:"success"`       // Success is true in case of success
+	Error         string `json:"error"`         // Error contains error descrition in case of failure
+	Service       string `json:"service"`       // Service name (to differentiate multiple requestors): 1..64
+	ID            string `json:"id"`            // Unique request ID: 1..64
+	PublicKey     string `json:"public_key"`    // Destination wallet address in Base58 (empty on failure)
+	Transaction   string `json:"transaction"`   // Transaction digest in Base58 (empty on failure)
+	Confirmations uint64 `json:"confirmations"` // Number of blocks on top of the transaction's block (zero on failure)
+}
+
+// LowBalanceEvent is notification model (posted to the configured low balance callback)
+type LowBalanceEvent struct {
+	PublicKey string `json:"public_key"` // Signer wallet address in Base58
+	Token     string `json:"token"`      // GOLD or MNT
+	Balance   string `json:"balance"`    // Signer balance in major units: 1.234 (18 decimal places)
+	Threshold string `json:"threshold"`  // Configured threshold in major units: 1.234 (18 decimal places)
 }
@@ -31,14 +31,15 @@ type Sent struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success     bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`        // Success is true in case of success
-	Error       string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`             // Error contains error descrition in case of failure
-	Service     string `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`         // Service name (to differentiate multiple requestors): 1..64
-	Id          string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`                   // Unique request ID: 1..64
-	PublicKey   string `protobuf:"bytes,5,opt,name=publicKey,proto3" json:"publicKey,omitempty"`     // Destination wallet address in Base58 (empty on failure)
-	Token       string `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`             // GOLD or MNT (empty on failure)
-	Amount      string `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`           // Token amount in major units: 1.234 (18 decimal places, empty on failure)
-	Transaction string `protobuf:"bytes,8,opt,name=transaction,proto3" json:"transaction,omitempty"` // Transaction digest in Base58 (empty on failure)
+	Success       bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`             // Success is true in case of success
+	Error         string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`                  // Error contains error descrition in case of failure
+	Service       string `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`              // Service name (to differentiate multiple requestors): 1..64
+	Id            string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`                        // Unique request ID: 1..64
+	PublicKey     string `protobuf:"bytes,5,opt,name=publicKey,proto3" json:"publicKey,omitempty"`          // Destination wallet address in Base58 (empty on failure)
+	Token         string `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`                  // GOLD or MNT (empty on failure)
+	Amount        string `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`                // Token amount in major units: 1.234 (18 decimal places, empty on failure)
+	Transaction   string `protobuf:"bytes,8,opt,name=transaction,proto3" json:"transaction,omitempty"`      // Transaction digest in Base58 (empty on failure)
+	Confirmations uint64 `protobuf:"varint,9,opt,name=confirmations,proto3" json:"confirmations,omitempty"` // Number of blocks on top of the transaction's block (zero on failure)
 }
 
 func (x *Sent) Reset() {
@@ -129,6 +130,13 @@ func (x *Sent) GetTransaction() string {
 	return ""
 }
 
+func (x *Sent) GetConfirmations() uint64 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
 // SentAck is a reply for Sent
 type SentAck struct {
 	state         protoimpl.MessageState
@@ -191,12 +199,13 @@ type Approved struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success     bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`        // Success is true in case of success
-	Error       string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`             // Error contains error descrition in case of failure
-	Service     string `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`         // Service name (to differentiate multiple requestors): 1..64
-	Id          string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`                   // Unique request ID: 1..64
-	PublicKey   string `protobuf:"bytes,5,opt,name=publicKey,proto3" json:"publicKey,omitempty"`     // Destination wallet address in Base58 (empty on failure)
-	Transaction string `protobuf:"bytes,6,opt,name=transaction,proto3" json:"transaction,omitempty"` // Transaction digest in Base58 (empty on failure)
+	Success       bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`             // Success is true in case of success
+	Error         string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`                  // Error contains error descrition in case of failure
+	Service       string `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`              // Service name (to differentiate multiple requestors): 1..64
+	Id            string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`                        // Unique request ID: 1..64
+	PublicKey     string `protobuf:"bytes,5,opt,name=publicKey,proto3" json:"publicKey,omitempty"`          // Destination wallet address in Base58 (empty on failure)
+	Transaction   string `protobuf:"bytes,6,opt,name=transaction,proto3" json:"transaction,omitempty"`      // Transaction digest in Base58 (empty on failure)
+	Confirmations uint64 `protobuf:"varint,7,opt,name=confirmations,proto3" json:"confirmations,omitempty"` // Number of blocks on top of the transaction's block (zero on failure)
 }
 
 func (x *Approved) Reset() {
@@ -273,6 +282,13 @@ func (x *Approved) GetTransaction() string {
 	return ""
 }
 
+func (x *Approved) GetConfirmations() uint64 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
 // ApprovedAck is a reply for Approved
 type ApprovedAck struct {
 	state         protoimpl.MessageState
@@ -329,45 +345,130 @@ func (x *ApprovedAck) GetError() string {
 	return ""
 }
 
+// LowBalance is an event from the service notifying a signer's balance is below the threshold (no reply expected)
+type LowBalance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey string `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"` // Signer wallet address in Base58
+	Token     string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`         // GOLD or MNT
+	Balance   string `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`     // Signer balance in major units: 1.234 (18 decimal places)
+	Threshold string `protobuf:"bytes,4,opt,name=threshold,proto3" json:"threshold,omitempty"` // Configured threshold in major units: 1.234 (18 decimal places)
+}
+
+func (x *LowBalance) Reset() {
+	*x = LowBalance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintsender_event_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LowBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LowBalance) ProtoMessage() {}
+
+func (x *LowBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_mintsender_event_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LowBalance.ProtoReflect.Descriptor instead.
+func (*LowBalance) Descriptor() ([]byte, []int) {
+	return file_mintsender_event_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LowBalance) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *LowBalance) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *LowBalance) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *LowBalance) GetThreshold() string {
+	if x != nil {
+		return x.Threshold
+	}
+	return ""
+}
+
 var File_mintsender_event_proto protoreflect.FileDescriptor
 
 var file_mintsender_event_proto_rawDesc = []byte{
 	0x0a, 0x16, 0x6d, 0x69, 0x6e, 0x74, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22,
-	0xce, 0x01, 0x0a, 0x04, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xf4, 0x01, 0x0a, 0x04, 0x53, 0x65, 0x6e, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x39, 0x0a,
+	0x07, 0x53, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
 	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
 	0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20,
-	0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x39, 0x0a, 0x07, 0x53, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa4, 0x01, 0x0a, 0x08,
-	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
-	0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x3d, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x41, 0x63,
-	0x6b, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x42, 0x24, 0x5a, 0x08, 0x2e, 0x3b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0xaa, 0x02, 0x17,
-	0x4d, 0x69, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xca, 0x01, 0x0a, 0x08, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a,
+	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3d, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x64, 0x41, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x78, 0x0a, 0x0a, 0x4c, 0x6f, 0x77, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x42, 0x24,
+	0x5a, 0x08, 0x2e, 0x3b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0xaa, 0x02, 0x17, 0x4d, 0x69, 0x6e,
+	0x74, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -382,12 +483,13 @@ func file_mintsender_event_proto_rawDescGZIP() []byte {
 	return file_mintsender_event_proto_rawDescData
 }
 
-var file_mintsender_event_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_mintsender_event_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_mintsender_event_proto_goTypes = []interface{}{
-	(*Sent)(nil),        // 0: event.Sent
-	(*SentAck)(nil),     // 1: event.SentAck
-	(*Approved)(nil),    // 2: event.Approved
-	(*ApprovedAck)(nil), // 3: event.ApprovedAck
+	(*Sent)(nil),        // 0: sender.event.Sent
+	(*SentAck)(nil),     // 1: sender.event.SentAck
+	(*Approved)(nil),    // 2: sender.event.Approved
+	(*ApprovedAck)(nil), // 3: sender.event.ApprovedAck
+	(*LowBalance)(nil),  // 4: sender.event.LowBalance
 }
 var file_mintsender_event_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -451,6 +553,18 @@ func file_mintsender_event_proto_init() {
 				return nil
 			}
 		}
+		file_mintsender_event_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LowBalance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -458,7 +572,7 @@ func file_mintsender_event_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mintsender_event_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
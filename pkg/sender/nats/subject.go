@@ -6,6 +6,9 @@ package sender
 // Subject getter
 func (m Send) Subject() string { return "mintsender.sender.send" }
 
+// Subject getter
+func (m SendBatch) Subject() string { return "mintsender.sender.sendbatch" }
+
 // Subject getter
 func (m Sent) Subject() string { return "mintsender.sender.sent" }
 
@@ -14,3 +17,19 @@ func (m Approve) Subject() string { return "mintsender.sender.approve" }
 
 // Subject getter
 func (m Approved) Subject() string { return "mintsender.sender.approved" }
+
+// Subject getter
+func (m Status) Subject() string { return "mintsender.sender.status" }
+
+
+// Subject getter
+func (m Cancel) Subject() string { return "mintsender.sender.cancel" }
+
+// Subject getter
+func (m LowBalance) Subject() string { return "mintsender.sender.lowbalance" }
+
+// Subject getter
+func (m DeadLetters) Subject() string { return "mintsender.sender.deadletters" }
+
+// Subject getter
+func (m Redeliver) Subject() string { return "mintsender.sender.redeliver" }
@@ -5,6 +5,8 @@ type WatchRequest struct {
 	Service    string   `json:"service"`     // Service name (to differentiate multiple requestors): 1..64
 	PublicKeys []string `json:"public_keys"` // Destination wallet address in Base58
 	Callback   string   `json:"callback"`    // Callback for notification: 1..256
+	Direction  string   `json:"direction"`   // Transactions direction to watch: in (default), out or both
+	Types      []string `json:"types"`       // Transaction types to watch: transfer_asset (default), set_wallet_tag, unset_wallet_tag, user_data
 }
 
 // UnwatchRequest is /unwatch request model
@@ -13,6 +15,22 @@ type UnwatchRequest struct {
 	PublicKeys []string `json:"public_keys"` // Destination wallet address in Base58
 }
 
+// DeadResponse is /admin/dead response model
+type DeadResponse struct {
+	Success bool       `json:"success"`         // Success is true in case of success
+	Error   string     `json:"error,omitempty"` // Error contains error descrition in case of failure
+	Items   []DeadItem `json:"items,omitempty"` // Transactions with notification in dead letters
+}
+
+// DeadItem is a single transaction within DeadResponse
+type DeadItem struct {
+	Service       string `json:"service"`         // Service name (to differentiate multiple requestors): 1..64
+	PublicKey     string `json:"public_key"`      // Watching wallet address in Base58
+	Transaction   string `json:"transaction"`     // Digest of the tx in Base58
+	Attempts      uint32 `json:"attempts"`        // Delivery attempts made
+	FirstNotifyAt string `json:"first_notify_at"` // Time of the first delivery attempt (RFC3339)
+}
+
 // RefillEvent is notification model
 type RefillEvent struct {
 	Service     string `json:"service"`     // Service name (to differentiate multiple requestors): 1..64
@@ -22,3 +40,16 @@ type RefillEvent struct {
 	Amount      string `json:"amount"`      // Token amount in major units: 1.234 (18 decimal places)
 	Transaction string `json:"transaction"` // Digest of the refilling tx in Base58
 }
+
+// TransactionEvent is notification model of a watching wallet's transaction other than refilling
+type TransactionEvent struct {
+	Service      string `json:"service"`      // Service name (to differentiate multiple requestors): 1..64
+	PublicKey    string `json:"public_key"`   // Watching wallet address in Base58
+	Counterparty string `json:"counterparty"` // Counterparty wallet address in Base58 (empty if the transaction has no counterparty)
+	Direction    string `json:"direction"`    // Transaction direction: in or out
+	Type         string `json:"type"`         // Transaction type: transfer_asset, set_wallet_tag, unset_wallet_tag, user_data
+	Token        string `json:"token"`        // GOLD or MNT (transfer_asset only)
+	Amount       string `json:"amount"`       // Token amount in major units: 1.234 (transfer_asset only)
+	Data         []byte `json:"data"`         // Wallet tag name (set_wallet_tag, unset_wallet_tag) or user data (user_data), Base64
+	Transaction  string `json:"transaction"`  // Digest of the tx in Base58
+}
@@ -0,0 +1,38 @@
+package watcher_test
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	sender "github.com/void616/gm.mint.sender/pkg/sender/nats"
+	watcher "github.com/void616/gm.mint.sender/pkg/watcher/nats"
+)
+
+// Both packages are imported by the same binary (cmd/cli), the registration of conflicting messages panics on init
+func TestProtoPackagesDontConflict(t *testing.T) {
+	pairs := [][2]proto.Message{
+		{&sender.Redeliver{}, &watcher.Redeliver{}},
+		{&sender.RedeliverReply{}, &watcher.RedeliverReply{}},
+		{&sender.DeadLetters{}, &watcher.DeadLetters{}},
+		{&sender.DeadLettersReply{}, &watcher.DeadLettersReply{}},
+	}
+	for _, p := range pairs {
+		s, w := proto.MessageName(p[0]), proto.MessageName(p[1])
+		if s == w {
+			t.Fatalf("sender and watcher share the message name %v", s)
+		}
+	}
+
+	// marshaling is still wire compatible
+	b, err := proto.Marshal(&watcher.Redeliver{Service: "svc", Transaction: "tx"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &watcher.Redeliver{}
+	if err := proto.Unmarshal(b, r); err != nil {
+		t.Fatal(err)
+	}
+	if r.Service != "svc" || r.Transaction != "tx" {
+		t.Fatalf("unexpected message %v", r)
+	}
+}
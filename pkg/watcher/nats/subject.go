@@ -8,3 +8,12 @@ func (m AddRemove) Subject() string { return "mintsender.watcher.watch" }
 
 // Subject getter
 func (m Refill) Subject() string { return "mintsender.watcher.refill" }
+
+// Subject getter
+func (m Transaction) Subject() string { return "mintsender.watcher.transaction" }
+
+// Subject getter
+func (m DeadLetters) Subject() string { return "mintsender.watcher.deadletters" }
+
+// Subject getter
+func (m Redeliver) Subject() string { return "mintsender.watcher.redeliver" }